@@ -0,0 +1,82 @@
+package main
+
+import (
+	"time"
+)
+
+// BusinessDayConvention the ISDA rule that moves a date falling on a day that
+// is not a business day of a calendar
+type BusinessDayConvention int
+
+// Business day conventions of the 2006 ISDA Definitions, section 4.12
+const (
+	Unadjusted        BusinessDayConvention = iota // the date is not moved
+	Following                                      // the next business day
+	ModifiedFollowing                              // the next business day, unless that is in the next month
+	Preceding                                      // the previous business day
+	ModifiedPreceding                              // the previous business day, unless that is in the previous month
+	Nearest                                        // the closest business day, the next one on a tie
+)
+
+var businessDayConventionNames = [...]string{
+	"Unadjusted", "Following", "Modified Following", "Preceding", "Modified Preceding", "Nearest",
+}
+
+// String returns the ISDA name of the convention
+func (convention BusinessDayConvention) String() string {
+	if convention < Unadjusted || convention > Nearest {
+		return "BusinessDayConvention(?)"
+	}
+	return businessDayConventionNames[convention]
+}
+
+// Adjust moves the date to a business day of the calendar by the convention.
+// A business day is never moved.
+func Adjust(cal HolidayCalendar, date time.Time, convention BusinessDayConvention) time.Time {
+	if convention == Unadjusted || cal.IsBusinessDay(date) {
+		return date
+	}
+	switch convention {
+	case Following:
+		return AddBusinessDays(cal, date, 1)
+	case ModifiedFollowing:
+		if adjusted := AddBusinessDays(cal, date, 1); adjusted.Month() == date.Month() {
+			return adjusted
+		}
+		return AddBusinessDays(cal, date, -1)
+	case Preceding:
+		return AddBusinessDays(cal, date, -1)
+	case ModifiedPreceding:
+		if adjusted := AddBusinessDays(cal, date, -1); adjusted.Month() == date.Month() {
+			return adjusted
+		}
+		return AddBusinessDays(cal, date, 1)
+	case Nearest:
+		following := AddBusinessDays(cal, date, 1)
+		preceding := AddBusinessDays(cal, date, -1)
+		if date.Sub(preceding) < following.Sub(date) {
+			return preceding
+		}
+		return following
+	}
+	return date
+}
+
+// isMonthEnd reports whether the date is the last day of its month
+func isMonthEnd(date time.Time) bool {
+	return date.AddDate(0, 0, 1).Day() == 1
+}
+
+// AddMonths moves the date n months forward, or backward when n is negative.
+// A day past the end of the target month becomes its last day, so January 31
+// plus one month is the end of February. With the end-of-month rule a date on
+// the last day of its month always moves to the last day of the target month,
+// so April 30 plus one month is May 31.
+func AddMonths(date time.Time, n int, endOfMonth bool) time.Time {
+	first := time.Date(date.Year(), date.Month()+time.Month(n), 1, date.Hour(), date.Minute(), date.Second(), date.Nanosecond(), date.Location())
+	last := returnMonthEnd(first)
+	if date.Day() >= last.Day() || endOfMonth && isMonthEnd(date) {
+		return last
+	}
+	return first.AddDate(0, 0, date.Day()-1)
+}
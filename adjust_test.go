@@ -0,0 +1,75 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAdjust(t *testing.T) {
+	date := func(yyyy int, mm time.Month, dd int) time.Time {
+		return time.Date(yyyy, mm, dd, 0, 0, 0, 0, time.UTC)
+	}
+	cal := NewTARGETCalendar()
+	tests := []struct {
+		date       time.Time
+		convention BusinessDayConvention
+		want       time.Time
+	}{
+		{date(2025, time.May, 30), ModifiedFollowing, date(2025, time.May, 30)},
+		{date(2025, time.May, 31), Unadjusted, date(2025, time.May, 31)},
+		// Saturday May 31 at the end of the month
+		{date(2025, time.May, 31), Following, date(2025, time.June, 2)},
+		{date(2025, time.May, 31), ModifiedFollowing, date(2025, time.May, 30)},
+		// Easter Sunday March 31, between Good Friday and Easter Monday
+		{date(2024, time.March, 31), Following, date(2024, time.April, 2)},
+		{date(2024, time.March, 31), ModifiedFollowing, date(2024, time.March, 28)},
+		// Sunday June 1 at the start of the month
+		{date(2025, time.June, 1), Preceding, date(2025, time.May, 30)},
+		{date(2025, time.June, 1), ModifiedPreceding, date(2025, time.June, 2)},
+		{date(2025, time.June, 14), Nearest, date(2025, time.June, 13)},
+		{date(2025, time.June, 15), Nearest, date(2025, time.June, 16)},
+	}
+	for _, test := range tests {
+		if got := Adjust(cal, test.date, test.convention); !got.Equal(test.want) {
+			t.Errorf("Adjust(%s, %s) = %s, want %s", test.date.Format("2006-01-02"), test.convention, got.Format("2006-01-02"), test.want.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestAddMonths(t *testing.T) {
+	date := func(yyyy int, mm time.Month, dd int) time.Time {
+		return time.Date(yyyy, mm, dd, 0, 0, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		date       time.Time
+		n          int
+		endOfMonth bool
+		want       time.Time
+	}{
+		{date(2025, time.January, 31), 1, false, date(2025, time.February, 28)},
+		{date(2025, time.January, 31), 1, true, date(2025, time.February, 28)},
+		{date(2024, time.January, 31), 1, false, date(2024, time.February, 29)},
+		{date(2024, time.January, 31), 1, true, date(2024, time.February, 29)},
+		{date(2025, time.February, 28), 1, false, date(2025, time.March, 28)},
+		{date(2025, time.February, 28), 1, true, date(2025, time.March, 31)},
+		{date(2025, time.April, 30), 1, false, date(2025, time.May, 30)},
+		{date(2025, time.April, 30), 1, true, date(2025, time.May, 31)},
+		{date(2025, time.April, 29), 1, true, date(2025, time.May, 29)},
+		{date(2025, time.March, 31), -1, false, date(2025, time.February, 28)},
+		{date(2025, time.November, 30), 3, true, date(2026, time.February, 28)},
+	}
+	for _, test := range tests {
+		if got := AddMonths(test.date, test.n, test.endOfMonth); !got.Equal(test.want) {
+			t.Errorf("AddMonths(%s, %d, %t) = %s, want %s", test.date.Format("2006-01-02"), test.n, test.endOfMonth, got.Format("2006-01-02"), test.want.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestBusinessDayConventionString(t *testing.T) {
+	if got := ModifiedFollowing.String(); got != "Modified Following" {
+		t.Errorf("ModifiedFollowing.String() = %q, want %q", got, "Modified Following")
+	}
+	if got := BusinessDayConvention(-1).String(); got != "BusinessDayConvention(?)" {
+		t.Errorf("BusinessDayConvention(-1).String() = %q", got)
+	}
+}
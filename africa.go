@@ -0,0 +1,115 @@
+package main
+
+import (
+	"time"
+)
+
+// Observers for the Islamic holidays of Nigeria and Kenya
+var (
+	Abuja   = Observer{Name: "Abuja", Latitude: 9.0765, Longitude: 7.3986, Location: time.FixedZone("UTC+1", 1*60*60)}
+	Nairobi = Observer{Name: "Nairobi", Latitude: -1.2921, Longitude: 36.8219, Location: time.FixedZone("UTC+3", 3*60*60)}
+)
+
+// southAfricaAddedHolidays the election days and holidays declared by the
+// President
+var southAfricaAddedHolidays = []Holiday{
+	{Name: "General Election Day", Date: time.Date(2014, time.May, 7, 0, 0, 0, 0, time.UTC)},
+	{Name: "Local Government Election Day", Date: time.Date(2016, time.August, 3, 0, 0, 0, 0, time.UTC)},
+	{Name: "General Election Day", Date: time.Date(2019, time.May, 8, 0, 0, 0, 0, time.UTC)},
+	{Name: "Local Government Election Day", Date: time.Date(2021, time.November, 1, 0, 0, 0, 0, time.UTC)},
+	{Name: "Public Holiday", Date: time.Date(2022, time.December, 27, 0, 0, 0, 0, time.UTC)},
+	{Name: "General Election Day", Date: time.Date(2024, time.May, 29, 0, 0, 0, 0, time.UTC)},
+}
+
+// southAfricaHolidayRules the holidays of the Public Holidays Act, each moved
+// to the Monday when it falls on a Sunday
+func southAfricaHolidayRules() []HolidayRule {
+	return []HolidayRule{
+		{Name: "New Year's Day", Anchor: FixedDateAnchor(time.January, 1), Observance: SundayToMonday},
+		{Name: "Human Rights Day", Anchor: FixedDateAnchor(time.March, 21), Observance: SundayToMonday},
+		{Name: "Good Friday", Anchor: EasterAnchor(), Offset: -2},
+		{Name: "Family Day", Anchor: EasterAnchor(), Offset: 1},
+		{Name: "Freedom Day", Anchor: FixedDateAnchor(time.April, 27), Observance: SundayToMonday},
+		{Name: "Workers' Day", Anchor: FixedDateAnchor(time.May, 1), Observance: SundayToMonday},
+		{Name: "Youth Day", Anchor: FixedDateAnchor(time.June, 16), Observance: SundayToMonday},
+		{Name: "National Women's Day", Anchor: FixedDateAnchor(time.August, 9), Observance: SundayToMonday},
+		{Name: "Heritage Day", Anchor: FixedDateAnchor(time.September, 24), Observance: SundayToMonday},
+		{Name: "Day of Reconciliation", Anchor: FixedDateAnchor(time.December, 16), Observance: SundayToMonday},
+		{Name: "Christmas Day", Anchor: FixedDateAnchor(time.December, 25), Observance: SundayToMonday},
+		{Name: "Day of Goodwill", Anchor: FixedDateAnchor(time.December, 26), Observance: SundayToMonday},
+	}
+}
+
+// newSouthAfricaRuleCalendar returns a calendar of the South African holidays
+// and the declared ones
+func newSouthAfricaRuleCalendar(name string) *RuleCalendar {
+	cal := NewRuleCalendar(name, saturdaySundayWeekend, southAfricaHolidayRules())
+	for _, holiday := range southAfricaAddedHolidays {
+		cal.AddHoliday(holiday.Name, holiday.Date)
+	}
+	return cal
+}
+
+// NewSouthAfricaCalendar returns the public holiday calendar of South Africa.
+// Use AddHoliday for election days declared after the built-in ones.
+func NewSouthAfricaCalendar() *RuleCalendar {
+	return newSouthAfricaRuleCalendar("South Africa")
+}
+
+// NewJSECalendar returns the trading calendar of the Johannesburg Stock
+// Exchange, which is closed on the South African public holidays
+func NewJSECalendar() *RuleCalendar {
+	return newSouthAfricaRuleCalendar("JSE")
+}
+
+// NewNigeriaCalendar returns the public holiday calendar of Nigeria. Holidays
+// on a weekend are given on the next working day. Democracy Day moved from
+// May 29 to June 12 in 2019. The Islamic holidays follow hijri, which defaults
+// to a crescent sighting estimate for Abuja.
+func NewNigeriaCalendar(hijri HijriCalendar) *RuleCalendar {
+	if hijri == nil {
+		hijri = NewCrescentHijri(Abuja)
+	}
+	weekend := SubstituteOn(time.Saturday, time.Sunday)
+	rules := []HolidayRule{
+		{Name: "New Year's Day", Anchor: FixedDateAnchor(time.January, 1), Substitute: weekend},
+		{Name: "Good Friday", Anchor: EasterAnchor(), Offset: -2},
+		{Name: "Easter Monday", Anchor: EasterAnchor(), Offset: 1},
+		{Name: "Workers' Day", Anchor: FixedDateAnchor(time.May, 1), Substitute: weekend},
+		{Name: "Democracy Day", Anchor: FixedDateAnchor(time.May, 29), FirstYear: 2000, LastYear: 2018, Substitute: weekend},
+		{Name: "Democracy Day", Anchor: FixedDateAnchor(time.June, 12), FirstYear: 2019, Substitute: weekend},
+		{Name: "Independence Day", Anchor: FixedDateAnchor(time.October, 1), Substitute: weekend},
+		{Name: "Christmas Day", Anchor: FixedDateAnchor(time.December, 25), Substitute: weekend},
+		{Name: "Boxing Day", Anchor: FixedDateAnchor(time.December, 26), Substitute: weekend},
+		{Name: "Eid el-Fitr", Anchor: HijriAnchor(hijri, Shawwal, 1), Substitute: weekend},
+		{Name: "Eid el-Fitr", Anchor: HijriAnchor(hijri, Shawwal, 2), Substitute: weekend},
+		{Name: "Eid el-Kabir", Anchor: HijriAnchor(hijri, DhuAlHijjah, 10), Substitute: weekend},
+		{Name: "Eid el-Kabir", Anchor: HijriAnchor(hijri, DhuAlHijjah, 11), Substitute: weekend},
+		{Name: "Eid el-Maulud", Anchor: HijriAnchor(hijri, RabiAlAwwal, 12), Substitute: weekend},
+	}
+	return NewRuleCalendar("Nigeria", saturdaySundayWeekend, rules)
+}
+
+// NewKenyaCalendar returns the public holiday calendar of Kenya. A holiday on
+// a Sunday is kept on the Monday. Eid al-Fitr follows hijri, which defaults to
+// a crescent sighting estimate for Nairobi.
+func NewKenyaCalendar(hijri HijriCalendar) *RuleCalendar {
+	if hijri == nil {
+		hijri = NewCrescentHijri(Nairobi)
+	}
+	rules := []HolidayRule{
+		{Name: "New Year's Day", Anchor: FixedDateAnchor(time.January, 1), Observance: SundayToMonday},
+		{Name: "Good Friday", Anchor: EasterAnchor(), Offset: -2},
+		{Name: "Easter Monday", Anchor: EasterAnchor(), Offset: 1},
+		{Name: "Labour Day", Anchor: FixedDateAnchor(time.May, 1), Observance: SundayToMonday},
+		{Name: "Madaraka Day", Anchor: FixedDateAnchor(time.June, 1), Observance: SundayToMonday},
+		{Name: "Moi Day", Anchor: FixedDateAnchor(time.October, 10), LastYear: 2009, Observance: SundayToMonday},
+		{Name: "Mazingira Day", Anchor: FixedDateAnchor(time.October, 10), FirstYear: 2024, Observance: SundayToMonday},
+		{Name: "Mashujaa Day", Anchor: FixedDateAnchor(time.October, 20), Observance: SundayToMonday},
+		{Name: "Jamhuri Day", Anchor: FixedDateAnchor(time.December, 12), Observance: SundayToMonday},
+		{Name: "Christmas Day", Anchor: FixedDateAnchor(time.December, 25), Observance: SundayToMonday},
+		{Name: "Boxing Day", Anchor: FixedDateAnchor(time.December, 26), Observance: SundayToMonday},
+		{Name: "Idd-ul-Fitr", Anchor: HijriAnchor(hijri, Shawwal, 1), Observance: SundayToMonday},
+	}
+	return NewRuleCalendar("Kenya", saturdaySundayWeekend, rules)
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"time"
+)
+
+// australiaAddedHolidays the holidays declared for a single year
+var australiaAddedHolidays = []Holiday{
+	{Name: "National Day of Mourning for Queen Elizabeth II", Date: time.Date(2022, time.September, 22, 0, 0, 0, 0, time.UTC)},
+}
+
+// australiaHolidayRules the bank holidays of New South Wales, the days the
+// Reserve Bank Information and Transfer System settles no Australian dollar
+// payments. New Year's Day, Australia Day, Christmas and Boxing Day on a
+// weekend give the next weekday off; Anzac Day does not.
+func australiaHolidayRules() []HolidayRule {
+	weekend := SubstituteOn(time.Saturday, time.Sunday)
+	return []HolidayRule{
+		{Name: "New Year's Day", Anchor: FixedDateAnchor(time.January, 1), Substitute: weekend},
+		{Name: "Australia Day", Anchor: FixedDateAnchor(time.January, 26), Substitute: weekend},
+		{Name: "Good Friday", Anchor: EasterAnchor(), Offset: -2},
+		{Name: "Easter Monday", Anchor: EasterAnchor(), Offset: 1},
+		{Name: "Anzac Day", Anchor: FixedDateAnchor(time.April, 25)},
+		{Name: "Queen's Birthday", Anchor: NthWeekdayAnchor(time.June, time.Monday, 2), LastYear: 2022},
+		{Name: "King's Birthday", Anchor: NthWeekdayAnchor(time.June, time.Monday, 2), FirstYear: 2023},
+		{Name: "Bank Holiday", Anchor: NthWeekdayAnchor(time.August, time.Monday, 1)},
+		{Name: "Labour Day", Anchor: NthWeekdayAnchor(time.October, time.Monday, 1)},
+		{Name: "Christmas Day", Anchor: FixedDateAnchor(time.December, 25), Substitute: weekend},
+		{Name: "Boxing Day", Anchor: FixedDateAnchor(time.December, 26), Substitute: weekend},
+	}
+}
+
+// NewAustraliaCalendar returns the bank holiday calendar of Sydney, on which
+// Australian dollar payments settle
+func NewAustraliaCalendar() *RuleCalendar {
+	cal := NewRuleCalendar("Australia", saturdaySundayWeekend, australiaHolidayRules())
+	for _, holiday := range australiaAddedHolidays {
+		cal.AddHoliday(holiday.Name, holiday.Date)
+	}
+	return cal
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"time"
+)
+
+// twoPMClose the hours of a bond market early close
+var twoPMClose = &SessionHours{Closes: 14 * time.Hour}
+
+// eveAnchor anchors a day on the weekday before the observed date of a fixed
+// date holiday, where the bond market closes early
+func eveAnchor(mm time.Month, dd int, observance ObservancePolicy) HolidayAnchor {
+	return func(yyyy int) []time.Time {
+		date := observance(time.Date(yyyy, mm, dd, 0, 0, 0, 0, time.UTC), saturdaySundayWeekend)
+		return []time.Time{PreviousWorkday(date.AddDate(0, 0, -1), saturdaySundayWeekend)}
+	}
+}
+
+// fedJuneteenth the Federal Reserve first closed for Juneteenth in 2022
+func fedJuneteenth(rules []HolidayRule) []HolidayRule {
+	for i := range rules {
+		if rules[i].Name == "Juneteenth National Independence Day" {
+			rules[i].FirstYear = 2022
+		}
+	}
+	return rules
+}
+
+// NewFedwireCalendar returns the holiday calendar of the Federal Reserve
+// Banks and the Fedwire services. They keep the federal holidays, but a
+// holiday on a Saturday is not observed on the Friday before.
+func NewFedwireCalendar() *RuleCalendar {
+	return NewRuleCalendar("Fedwire", saturdaySundayWeekend, fedJuneteenth(usFederalRules(SundayToMonday)))
+}
+
+// sifmaGoodFridayEarlyCloses the years SIFMA recommended trading until noon
+// on Good Friday, when it fell on the day of the employment report, taken
+// from the holiday schedule recommendations SIFMA publishes at
+// www.sifma.org/resources/general/holiday-schedule from 2007
+var sifmaGoodFridayEarlyCloses = map[int]bool{
+	2007: true, 2010: true, 2012: true, 2015: true, 2021: true, 2023: true, 2026: true,
+}
+
+// sifmaGoodFridayAnchor anchors Good Friday in the years it is, or is not
+// when early is false, an early close
+func sifmaGoodFridayAnchor(early bool) HolidayAnchor {
+	return func(yyyy int) []time.Time {
+		if sifmaGoodFridayEarlyCloses[yyyy] != early {
+			return nil
+		}
+		return []time.Time{calculateGregorianGoodFriday(yyyy)}
+	}
+}
+
+// NewSIFMACalendar returns the US bond market calendar recommended by SIFMA.
+// The market closes on the federal holidays and Good Friday, observing a
+// Saturday holiday on the Friday before except New Year's Day. The early
+// closes at 14:00 on the days before Good Friday, Memorial Day, Independence
+// Day, Christmas and New Year's Day and after Thanksgiving are partial days,
+// given by Session.
+func NewSIFMACalendar() *RuleCalendar {
+	rules := fedJuneteenth(usFederalRules(NearestWorkday))
+	for i := range rules {
+		if rules[i].Name == "New Year's Day" {
+			rules[i].Observance = SundayToMonday
+		}
+	}
+	rules = append(rules,
+		HolidayRule{Name: "Good Friday", Anchor: sifmaGoodFridayAnchor(false)},
+		HolidayRule{Name: "Good Friday", Anchor: sifmaGoodFridayAnchor(true), Hours: &SessionHours{Closes: 12 * time.Hour}},
+		HolidayRule{Name: "Holy Thursday", Anchor: EasterAnchor(), Offset: -3, Hours: twoPMClose},
+		HolidayRule{Name: "Day before Memorial Day", Anchor: NthWeekdayAnchor(time.May, time.Monday, -1), Offset: -3, Hours: twoPMClose},
+		HolidayRule{Name: "Day before Independence Day", Anchor: eveAnchor(time.July, 4, NearestWorkday), Hours: twoPMClose},
+		HolidayRule{Name: "Day after Thanksgiving", Anchor: thanksgivingAnchor, Offset: 1, Hours: twoPMClose},
+		HolidayRule{Name: "Christmas Eve", Anchor: eveAnchor(time.December, 25, NearestWorkday), Hours: twoPMClose},
+		HolidayRule{Name: "New Year's Eve", Anchor: eveAnchor(time.January, 1, SundayToMonday), Hours: twoPMClose},
+	)
+	return NewRuleCalendar("SIFMA", saturdaySundayWeekend, rules)
+}
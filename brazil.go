@@ -0,0 +1,60 @@
+package main
+
+import (
+	"time"
+)
+
+// brazilHolidayRules the national holidays of Brazil, with Carnival and
+// Corpus Christi, which are optional days for the federal government but
+// holidays for the banks. Black Consciousness Day became a national holiday
+// in 2024.
+func brazilHolidayRules() []HolidayRule {
+	return []HolidayRule{
+		{Name: "Confraternização Universal", Anchor: FixedDateAnchor(time.January, 1)},
+		{Name: "Carnaval", Anchor: EasterAnchor(), Offset: -48},
+		{Name: "Carnaval", Anchor: EasterAnchor(), Offset: -47},
+		{Name: "Sexta-feira Santa", Anchor: EasterAnchor(), Offset: -2},
+		{Name: "Tiradentes", Anchor: FixedDateAnchor(time.April, 21)},
+		{Name: "Dia do Trabalho", Anchor: FixedDateAnchor(time.May, 1)},
+		{Name: "Corpus Christi", Anchor: EasterAnchor(), Offset: 60},
+		{Name: "Independência do Brasil", Anchor: FixedDateAnchor(time.September, 7)},
+		{Name: "Nossa Senhora Aparecida", Anchor: FixedDateAnchor(time.October, 12)},
+		{Name: "Finados", Anchor: FixedDateAnchor(time.November, 2)},
+		{Name: "Proclamação da República", Anchor: FixedDateAnchor(time.November, 15)},
+		{Name: "Dia Nacional de Zumbi e da Consciência Negra", Anchor: FixedDateAnchor(time.November, 20), FirstYear: 2024},
+		{Name: "Natal", Anchor: FixedDateAnchor(time.December, 25)},
+	}
+}
+
+// NewBrazilCalendar returns the national holiday calendar of Brazil
+func NewBrazilCalendar() *RuleCalendar {
+	return NewRuleCalendar("Brazil", saturdaySundayWeekend, brazilHolidayRules())
+}
+
+// NewSaoPauloCalendar returns the holiday calendar of the city of São Paulo,
+// the national holidays with the anniversary of the city, the São Paulo state
+// holiday of July 9 and, before it became national, Black Consciousness Day
+func NewSaoPauloCalendar() *RuleCalendar {
+	rules := append(brazilHolidayRules(),
+		HolidayRule{Name: "Aniversário de São Paulo", Anchor: FixedDateAnchor(time.January, 25)},
+		HolidayRule{Name: "Revolução Constitucionalista", Anchor: FixedDateAnchor(time.July, 9)},
+		HolidayRule{Name: "Dia da Consciência Negra", Anchor: FixedDateAnchor(time.November, 20), FirstYear: 2004, LastYear: 2023},
+	)
+	return NewRuleCalendar("São Paulo", saturdaySundayWeekend, rules)
+}
+
+// NewB3Calendar returns the trading calendar of B3, the São Paulo exchange.
+// B3 is closed on the national holidays, Christmas Eve and the last day of
+// the year, and trades from 13:00 on Ash Wednesday. It traded on the São
+// Paulo city and state holidays from 2022.
+func NewB3Calendar() *RuleCalendar {
+	rules := append(brazilHolidayRules(),
+		HolidayRule{Name: "Quarta-feira de Cinzas", Anchor: EasterAnchor(), Offset: -46, Hours: &SessionHours{Opens: 13 * time.Hour}},
+		HolidayRule{Name: "Aniversário de São Paulo", Anchor: FixedDateAnchor(time.January, 25), LastYear: 2021},
+		HolidayRule{Name: "Revolução Constitucionalista", Anchor: FixedDateAnchor(time.July, 9), LastYear: 2021},
+		HolidayRule{Name: "Dia da Consciência Negra", Anchor: FixedDateAnchor(time.November, 20), FirstYear: 2004, LastYear: 2021},
+		HolidayRule{Name: "Véspera de Natal", Anchor: FixedDateAnchor(time.December, 24)},
+		HolidayRule{Name: "Último Dia do Ano", Anchor: FixedDateAnchor(time.December, 31)},
+	)
+	return NewRuleCalendar("B3", saturdaySundayWeekend, rules)
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"time"
+)
+
+// AddBusinessDays moves the date n business days of the calendar forward, or
+// backward when n is negative
+func AddBusinessDays(cal HolidayCalendar, date time.Time, n int) time.Time {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for n > 0 {
+		date = date.AddDate(0, 0, step)
+		if cal.IsBusinessDay(date) {
+			n--
+		}
+	}
+	return date
+}
+
+// BusinessDaysBetween counts the business days of the calendar after start up
+// to and including end. The count is negative when end is before start.
+func BusinessDaysBetween(cal HolidayCalendar, start, end time.Time) int {
+	if end.Before(start) {
+		return -BusinessDaysBetween(cal, end, start)
+	}
+	count := 0
+	for date := start.AddDate(0, 0, 1); !date.After(end); date = date.AddDate(0, 0, 1) {
+		if cal.IsBusinessDay(date) {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,287 @@
+package main
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Holiday a named holiday on a date, as listed by a HolidayCalendar. Hours
+// is set for partial days, such as a late open or an early close, which are
+// listed but are not holidays.
+type Holiday struct {
+	Name  string        `json:"Name" yaml:"Name" bson:"Name"`
+	Date  time.Time     `json:"Date" yaml:"Date" bson:"Date"`
+	Hours *SessionHours `json:"Hours,omitempty" yaml:"Hours,omitempty" bson:"Hours,omitempty"`
+}
+
+// HolidayCalendar the holidays and business days of a country, market or
+// settlement system
+type HolidayCalendar interface {
+	// Name returns the name of the calendar
+	Name() string
+	// Holidays returns the holidays in the year yyyy in date order
+	Holidays(yyyy int) []Holiday
+	// IsHoliday reports whether the date is a holiday
+	IsHoliday(date time.Time) bool
+	// IsBusinessDay reports whether the date is neither a weekend day nor a holiday
+	IsBusinessDay(date time.Time) bool
+}
+
+// WeekendPeriod the weekend days in effect from a date onwards
+type WeekendPeriod struct {
+	From time.Time // first date of the period, zero for the earliest period
+	Days []time.Weekday
+}
+
+// WeekendSchedule the weekend days of a calendar over time. Countries and
+// markets do change their weekend, so each period applies from its From date
+// until the From date of the next period. Periods are ordered by From.
+type WeekendSchedule []WeekendPeriod
+
+// Weekend returns a schedule with the same weekend days at all dates
+func Weekend(days ...time.Weekday) WeekendSchedule {
+	return WeekendSchedule{{Days: days}}
+}
+
+// IsWeekend reports whether the date is a weekend day under the schedule
+func (schedule WeekendSchedule) IsWeekend(date time.Time) bool {
+	var days []time.Weekday
+	for _, period := range schedule {
+		if period.From.After(date) {
+			break
+		}
+		days = period.Days
+	}
+	for _, day := range days {
+		if date.Weekday() == day {
+			return true
+		}
+	}
+	return false
+}
+
+// HolidayRule one holiday of a RuleCalendar. The holiday falls Offset days
+// after each anchor date in the years FirstYear to LastYear, and is moved off
+// the weekend of the calendar by the Observance policy. When the Substitute
+// policy applies, or the holiday shares its date with another one, the next
+// day that is neither a weekend day nor a holiday is a substitute holiday.
+// A rule with Hours makes a partial day rather than a holiday.
+type HolidayRule struct {
+	Name       string
+	Anchor     HolidayAnchor
+	Offset     int                // days added to the anchor dates
+	FirstYear  int                // first year the holiday is observed, 0 if there is none
+	LastYear   int                // last year the holiday is observed, 0 if there is none
+	Observance ObservancePolicy   // nil when the holiday is not moved
+	Substitute SubstitutionPolicy // nil when the holiday earns no substitute day
+	Hours      *SessionHours      // nil when the market is closed all day
+}
+
+// inEffect reports whether the rule applies in the year yyyy
+func (rule HolidayRule) inEffect(yyyy int) bool {
+	return (rule.FirstYear == 0 || yyyy >= rule.FirstYear) && (rule.LastYear == 0 || yyyy <= rule.LastYear)
+}
+
+// dates returns the observed dates of the holiday anchored in the year yyyy
+func (rule HolidayRule) dates(yyyy int, weekend WeekendSchedule) []time.Time {
+	if !rule.inEffect(yyyy) {
+		return nil
+	}
+	var dates []time.Time
+	for _, date := range rule.Anchor(yyyy) {
+		date = date.AddDate(0, 0, rule.Offset)
+		if rule.Observance != nil {
+			date = rule.Observance(date, weekend)
+		}
+		dates = append(dates, date)
+	}
+	return dates
+}
+
+// announceDates anchors the rules named in announced on the announced
+// holiday dates, in the years that have them
+func announceDates(rules []HolidayRule, announced map[string][]time.Time) []HolidayRule {
+	for i, rule := range rules {
+		dates, ok := announced[rule.Name]
+		if !ok {
+			continue
+		}
+		anchors := make([]time.Time, len(dates))
+		for j, date := range dates {
+			anchors[j] = date.AddDate(0, 0, -rule.Offset)
+		}
+		rules[i].Anchor = AnnouncedAnchor(rule.Anchor, anchors...)
+	}
+	return rules
+}
+
+// RuleCalendar a HolidayCalendar computed from holiday rules and a weekend
+// schedule. The holidays of a year are computed once and then cached.
+type RuleCalendar struct {
+	name    string
+	weekend WeekendSchedule
+	rules   []HolidayRule
+	added   []Holiday
+	working map[int]bool
+	mutex   sync.Mutex
+	years   map[int]*calendarYear
+}
+
+// calendarYear the holidays of one year of a RuleCalendar
+type calendarYear struct {
+	holidays []Holiday
+	dates    map[int]bool
+	sessions map[int]SessionHours
+}
+
+// NewRuleCalendar creates a calendar from a weekend schedule and holiday rules
+func NewRuleCalendar(name string, weekend WeekendSchedule, rules []HolidayRule) *RuleCalendar {
+	return &RuleCalendar{name: name, weekend: weekend, rules: rules, working: make(map[int]bool), years: make(map[int]*calendarYear)}
+}
+
+// Name returns the name of the calendar
+func (cal *RuleCalendar) Name() string {
+	return cal.name
+}
+
+// Holidays returns the holidays in the year yyyy in date order
+func (cal *RuleCalendar) Holidays(yyyy int) []Holiday {
+	return append([]Holiday(nil), cal.year(yyyy).holidays...)
+}
+
+// IsHoliday reports whether the date is a holiday
+func (cal *RuleCalendar) IsHoliday(date time.Time) bool {
+	return cal.year(date.Year()).dates[dateKey(date)]
+}
+
+// IsWeekend reports whether the date is a weekend day of the calendar that
+// has not been made a working day
+func (cal *RuleCalendar) IsWeekend(date time.Time) bool {
+	cal.mutex.Lock()
+	defer cal.mutex.Unlock()
+	return cal.isWeekend(date)
+}
+
+// isWeekend reports whether the date is a weekend day, the mutex held
+func (cal *RuleCalendar) isWeekend(date time.Time) bool {
+	return cal.weekend.IsWeekend(date) && !cal.working[dateKey(date)]
+}
+
+// IsBusinessDay reports whether the date is neither a weekend day nor a holiday
+func (cal *RuleCalendar) IsBusinessDay(date time.Time) bool {
+	return !cal.IsWeekend(date) && !cal.IsHoliday(date)
+}
+
+// AddHoliday adds a one-off holiday to the calendar, such as an election day
+// or a holiday declared by decree
+func (cal *RuleCalendar) AddHoliday(name string, date time.Time) {
+	cal.mutex.Lock()
+	defer cal.mutex.Unlock()
+	cal.added = append(cal.added, Holiday{Name: name, Date: date})
+	// the holiday can take the place of a substitute day in a neighbouring year
+	cal.years = make(map[int]*calendarYear)
+}
+
+// AddWorkingDay makes a weekend day a working day, as when a government moves
+// the day off to bridge a holiday and the weekend
+func (cal *RuleCalendar) AddWorkingDay(date time.Time) {
+	cal.mutex.Lock()
+	defer cal.mutex.Unlock()
+	cal.working[dateKey(date)] = true
+	// substitute days go to the first working day
+	cal.years = make(map[int]*calendarYear)
+}
+
+// year returns the holidays of the year yyyy, computing them on first use
+func (cal *RuleCalendar) year(yyyy int) *calendarYear {
+	cal.mutex.Lock()
+	defer cal.mutex.Unlock()
+	if year, found := cal.years[yyyy]; found {
+		return year
+	}
+	// offsets, observance and substitute days can move a holiday across the
+	// new year, so look at the rules anchored in the neighbouring years as well
+	var holidays, substituted []Holiday
+	var policies []SubstitutionPolicy
+	taken := make(map[int]int)
+	for anchorYear := yyyy - 1; anchorYear <= yyyy+1; anchorYear++ {
+		for _, rule := range cal.rules {
+			for _, date := range rule.dates(anchorYear, cal.weekend) {
+				holidays = append(holidays, Holiday{Name: rule.Name, Date: date, Hours: rule.Hours})
+				if rule.Hours != nil {
+					continue
+				}
+				taken[dateKey(date)]++
+				if rule.Substitute != nil {
+					substituted = append(substituted, Holiday{Name: rule.Name, Date: date})
+					policies = append(policies, rule.Substitute)
+				}
+			}
+		}
+	}
+	for _, holiday := range cal.added {
+		holidays = append(holidays, holiday)
+		taken[dateKey(holiday.Date)]++
+	}
+	holidays = append(holidays, cal.substitutes(substituted, policies, taken)...)
+	year := &calendarYear{dates: make(map[int]bool), sessions: make(map[int]SessionHours)}
+	for _, holiday := range holidays {
+		if holiday.Date.Year() != yyyy {
+			continue
+		}
+		year.holidays = append(year.holidays, holiday)
+		if holiday.Hours != nil {
+			year.sessions[dateKey(holiday.Date)] = *holiday.Hours
+		} else {
+			year.dates[dateKey(holiday.Date)] = true
+		}
+	}
+	sort.SliceStable(year.holidays, func(i, j int) bool {
+		return year.holidays[i].Date.Before(year.holidays[j].Date)
+	})
+	cal.years[yyyy] = year
+	return year
+}
+
+// substitutes returns the substitute holidays of the holidays with a
+// substitution policy, where taken counts the holidays on each date. A date
+// earns one substitute day for each holiday that falls on it beyond the
+// first, and one more when the policy of one of its holidays applies.
+// Substitute days are handed out in date order.
+func (cal *RuleCalendar) substitutes(holidays []Holiday, policies []SubstitutionPolicy, taken map[int]int) []Holiday {
+	order := make([]int, len(holidays))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return holidays[order[i]].Date.Before(holidays[order[j]].Date)
+	})
+	var substitutes []Holiday
+	for i := 0; i < len(order); {
+		date := holidays[order[i]].Date
+		j, applies := i, false
+		for ; j < len(order) && holidays[order[j]].Date.Equal(date); j++ {
+			applies = applies || policies[order[j]](date)
+		}
+		lost := taken[dateKey(date)] - 1
+		if applies {
+			lost++
+		}
+		for k := i; k < j && k-i < lost; k++ {
+			substitute := date.AddDate(0, 0, 1)
+			for cal.isWeekend(substitute) || taken[dateKey(substitute)] > 0 {
+				substitute = substitute.AddDate(0, 0, 1)
+			}
+			taken[dateKey(substitute)]++
+			substitutes = append(substitutes, Holiday{Name: "Substitute Holiday (" + holidays[order[k]].Name + ")", Date: substitute})
+		}
+		i = j
+	}
+	return substitutes
+}
+
+// dateKey returns a map key for the calendar date of t
+func dateKey(t time.Time) int {
+	return t.Year()*10000 + int(t.Month())*100 + t.Day()
+}
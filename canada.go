@@ -0,0 +1,34 @@
+package main
+
+import (
+	"time"
+)
+
+// canadaHolidayRules the bank holidays of Canada, the days the Lynx payment
+// system of Payments Canada is closed. New Year's Day, Canada Day,
+// Remembrance Day, Christmas and Boxing Day on a weekend give the next
+// weekday off.
+func canadaHolidayRules() []HolidayRule {
+	weekend := SubstituteOn(time.Saturday, time.Sunday)
+	return []HolidayRule{
+		{Name: "New Year's Day", Anchor: FixedDateAnchor(time.January, 1), Substitute: weekend},
+		{Name: "Family Day", Anchor: NthWeekdayAnchor(time.February, time.Monday, 3), FirstYear: 2008},
+		{Name: "Good Friday", Anchor: EasterAnchor(), Offset: -2},
+		// the last Monday before May 25
+		{Name: "Victoria Day", Anchor: WeekdayInWindowAnchor(time.Monday, time.May, 18, time.May, 24)},
+		{Name: "Canada Day", Anchor: FixedDateAnchor(time.July, 1), Substitute: weekend},
+		{Name: "Civic Holiday", Anchor: NthWeekdayAnchor(time.August, time.Monday, 1)},
+		{Name: "Labour Day", Anchor: NthWeekdayAnchor(time.September, time.Monday, 1)},
+		{Name: "National Day for Truth and Reconciliation", Anchor: FixedDateAnchor(time.September, 30), FirstYear: 2021, Substitute: weekend},
+		{Name: "Thanksgiving Day", Anchor: NthWeekdayAnchor(time.October, time.Monday, 2)},
+		{Name: "Remembrance Day", Anchor: FixedDateAnchor(time.November, 11), Substitute: weekend},
+		{Name: "Christmas Day", Anchor: FixedDateAnchor(time.December, 25), Substitute: weekend},
+		{Name: "Boxing Day", Anchor: FixedDateAnchor(time.December, 26), Substitute: weekend},
+	}
+}
+
+// NewCanadaCalendar returns the bank holiday calendar of Canada, on which
+// Canadian dollar payments settle
+func NewCanadaCalendar() *RuleCalendar {
+	return NewRuleCalendar("Canada", saturdaySundayWeekend, canadaHolidayRules())
+}
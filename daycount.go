@@ -0,0 +1,173 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// DayCount a day count convention, which gives the fraction of a year
+// between two dates for accruing interest
+type DayCount int
+
+// Day count conventions of the 2006 ISDA Definitions, section 4.16, with the
+// ICMA rule 251 and the business day count of the Brazilian market
+const (
+	Act360         DayCount = iota // actual days over 360
+	Act365Fixed                    // actual days over 365
+	ActActISDA                     // actual days over the days of each calendar year
+	ActActICMA                     // actual days over the days of the coupon period times the coupons a year
+	Thirty360                      // 30/360 bond basis
+	Thirty360US                    // 30/360 US, with the rule for the end of February
+	ThirtyE360                     // 30E/360 Eurobond basis
+	ThirtyE360ISDA                 // 30E/360 ISDA, with month ends as the 30th
+	Bus252                         // business days of a calendar over 252
+)
+
+var dayCountNames = [...]string{
+	"ACT/360", "ACT/365F", "ACT/ACT ISDA", "ACT/ACT ICMA", "30/360", "30/360 US", "30E/360", "30E/360 ISDA", "BUS/252",
+}
+
+// String returns the usual name of the day count
+func (dc DayCount) String() string {
+	if dc < Act360 || dc > Bus252 {
+		return "DayCount(?)"
+	}
+	return dayCountNames[dc]
+}
+
+// DayCountReference what some day counts need beyond the two dates. BUS/252
+// counts the business days of Calendar from start, included, to end,
+// excluded; ACT/ACT ICMA divides by the regular coupon period from Start to
+// End, or the accrual period itself when Start is zero, of Frequency, annual
+// when zero; 30E/360 ISDA keeps the last day of February when it is the
+// Maturity.
+type DayCountReference struct {
+	Calendar  HolidayCalendar
+	Start     time.Time
+	End       time.Time
+	Frequency Frequency
+	Maturity  time.Time
+}
+
+// YearFraction returns the fraction of a year from start to end under the
+// day count. It is negative when end is before start. BUS/252 fails without
+// a Calendar in ref.
+func YearFraction(dc DayCount, start, end time.Time, ref DayCountReference) (float64, error) {
+	if end.Before(start) {
+		fraction, err := YearFraction(dc, end, start, ref)
+		return -fraction, err
+	}
+	switch dc {
+	case Act360:
+		return float64(daysBetween(start, end)) / 360, nil
+	case Act365Fixed:
+		return float64(daysBetween(start, end)) / 365, nil
+	case ActActISDA:
+		return actActISDA(start, end), nil
+	case ActActICMA:
+		months := int(ref.Frequency)
+		if months == 0 {
+			months = int(Annual)
+		}
+		if ref.Start.IsZero() {
+			return actActICMA(start, end, start, end, months), nil
+		}
+		return actActICMA(start, end, ref.Start, ref.End, months), nil
+	case Thirty360, Thirty360US, ThirtyE360, ThirtyE360ISDA:
+		return thirty360(dc, start, end, ref.Maturity), nil
+	case Bus252:
+		if ref.Calendar == nil {
+			return 0, fmt.Errorf("day count %s needs a holiday calendar", dc)
+		}
+		// the business days from start up to but not including end
+		return float64(BusinessDaysBetween(ref.Calendar, start.AddDate(0, 0, -1), end.AddDate(0, 0, -1))) / 252, nil
+	}
+	return 0, fmt.Errorf("invalid day count %d", dc)
+}
+
+// daysBetween the actual number of days from start to end
+func daysBetween(start, end time.Time) int {
+	return int(end.Sub(start).Hours()/24 + 0.5)
+}
+
+// actActISDA divides the days in each calendar year by the days of that year
+func actActISDA(start, end time.Time) float64 {
+	fraction := 0.0
+	for start.Year() < end.Year() {
+		next := time.Date(start.Year()+1, time.January, 1, 0, 0, 0, 0, start.Location())
+		fraction += float64(daysBetween(start, next)) / float64(daysInYear(start.Year()))
+		start = next
+	}
+	return fraction + float64(daysBetween(start, end))/float64(daysInYear(end.Year()))
+}
+
+// daysInYear the days of the year yyyy
+func daysInYear(yyyy int) int {
+	if time.Date(yyyy, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay() == 366 {
+		return 366
+	}
+	return 365
+}
+
+// actActICMA divides the days by the days of the reference period times the
+// periods a year. A stub longer than the reference period is split into
+// notional periods of the frequency.
+func actActICMA(start, end, refStart, refEnd time.Time, months int) float64 {
+	if start.Before(refStart) && end.After(refStart) {
+		return actActICMA(start, refStart, AddMonths(refStart, -months, false), refStart, months) +
+			actActICMA(refStart, end, refStart, refEnd, months)
+	}
+	if end.After(refEnd) && start.Before(refEnd) {
+		return actActICMA(start, refEnd, refStart, refEnd, months) +
+			actActICMA(refEnd, end, refEnd, AddMonths(refEnd, months, false), months)
+	}
+	return float64(daysBetween(start, end)) / (12 / float64(months) * float64(daysBetween(refStart, refEnd)))
+}
+
+// isEndOfFebruary reports whether the date is the last day of February
+func isEndOfFebruary(date time.Time) bool {
+	return date.Month() == time.February && isMonthEnd(date)
+}
+
+// thirty360 counts months of 30 days, with the day adjustments of dc
+func thirty360(dc DayCount, start, end, maturity time.Time) float64 {
+	d1, d2 := start.Day(), end.Day()
+	switch dc {
+	case Thirty360:
+		if d1 == 31 {
+			d1 = 30
+		}
+		if d2 == 31 && d1 == 30 {
+			d2 = 30
+		}
+	case Thirty360US:
+		if isEndOfFebruary(start) && isEndOfFebruary(end) {
+			d2 = 30
+		}
+		if isEndOfFebruary(start) {
+			d1 = 30
+		}
+		if d2 == 31 && d1 >= 30 {
+			d2 = 30
+		}
+		if d1 == 31 {
+			d1 = 30
+		}
+	case ThirtyE360:
+		if d1 == 31 {
+			d1 = 30
+		}
+		if d2 == 31 {
+			d2 = 30
+		}
+	case ThirtyE360ISDA:
+		if isMonthEnd(start) {
+			d1 = 30
+		}
+		if isMonthEnd(end) && !(isEndOfFebruary(end) && end.Equal(maturity)) {
+			d2 = 30
+		}
+	}
+	days := 360*(end.Year()-start.Year()) + 30*(int(end.Month())-int(start.Month())) + d2 - d1
+	return float64(days) / 360
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestYearFraction(t *testing.T) {
+	date := func(yyyy int, mm time.Month, dd int) time.Time {
+		return time.Date(yyyy, mm, dd, 0, 0, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		dc         DayCount
+		start, end time.Time
+		ref        DayCountReference
+		want       float64
+	}{
+		// the examples of the ISDA memo "EMU and market conventions: recent
+		// developments" of 1998
+		{ActActISDA, date(2003, time.November, 1), date(2004, time.May, 1), DayCountReference{}, 0.497724380567},
+		{ActActICMA, date(2003, time.November, 1), date(2004, time.May, 1),
+			DayCountReference{Start: date(2003, time.November, 1), End: date(2004, time.May, 1), Frequency: SemiAnnual}, 0.5},
+		// short first period
+		{ActActISDA, date(1999, time.February, 1), date(1999, time.July, 1), DayCountReference{}, 0.410958904110},
+		{ActActICMA, date(1999, time.February, 1), date(1999, time.July, 1),
+			DayCountReference{Start: date(1998, time.July, 1), End: date(1999, time.July, 1), Frequency: Annual}, 0.410958904110},
+		// long first period, split at the notional coupon date
+		{ActActISDA, date(2002, time.August, 15), date(2003, time.July, 15), DayCountReference{}, 0.915068493151},
+		{ActActICMA, date(2002, time.August, 15), date(2003, time.July, 15),
+			DayCountReference{Start: date(2003, time.January, 15), End: date(2003, time.July, 15), Frequency: SemiAnnual}, 0.915760869565},
+		// short final period
+		{ActActISDA, date(1999, time.July, 30), date(2000, time.January, 30), DayCountReference{}, 0.503892506924},
+		{ActActICMA, date(1999, time.July, 30), date(2000, time.January, 30),
+			DayCountReference{Start: date(1999, time.July, 30), End: date(2000, time.January, 30), Frequency: SemiAnnual}, 0.5},
+		{Act360, date(2003, time.November, 1), date(2004, time.May, 1), DayCountReference{}, 182.0 / 360},
+		{Act365Fixed, date(2003, time.November, 1), date(2004, time.May, 1), DayCountReference{}, 182.0 / 365},
+		// the 30/360 variants on the end of February and the 31st
+		{Thirty360, date(2007, time.February, 28), date(2007, time.March, 31), DayCountReference{}, 33.0 / 360},
+		{Thirty360US, date(2007, time.February, 28), date(2007, time.March, 31), DayCountReference{}, 30.0 / 360},
+		{ThirtyE360, date(2007, time.February, 28), date(2007, time.March, 31), DayCountReference{}, 32.0 / 360},
+		{ThirtyE360ISDA, date(2007, time.February, 28), date(2007, time.March, 31), DayCountReference{}, 30.0 / 360},
+		{Thirty360, date(2007, time.August, 31), date(2008, time.February, 29), DayCountReference{}, 179.0 / 360},
+		{Thirty360US, date(2007, time.August, 31), date(2008, time.February, 29), DayCountReference{}, 179.0 / 360},
+		{ThirtyE360, date(2007, time.August, 31), date(2008, time.February, 29), DayCountReference{}, 179.0 / 360},
+		{ThirtyE360ISDA, date(2007, time.August, 31), date(2008, time.February, 29), DayCountReference{}, 180.0 / 360},
+		{ThirtyE360ISDA, date(2007, time.August, 31), date(2008, time.February, 29), DayCountReference{Maturity: date(2008, time.February, 29)}, 179.0 / 360},
+		{Thirty360US, date(2008, time.February, 29), date(2009, time.February, 28), DayCountReference{}, 360.0 / 360},
+		// 2025 had 252 business days in Brazil
+		{Bus252, date(2025, time.January, 2), date(2026, time.January, 2), DayCountReference{Calendar: NewBrazilCalendar()}, 1},
+		// the start counts and the end does not: Tiradentes on Monday April 21
+		// and Good Friday April 18
+		{Bus252, date(2025, time.April, 21), date(2025, time.April, 25), DayCountReference{Calendar: NewBrazilCalendar()}, 3.0 / 252},
+		{Bus252, date(2025, time.April, 14), date(2025, time.April, 21), DayCountReference{Calendar: NewBrazilCalendar()}, 4.0 / 252},
+	}
+	for _, test := range tests {
+		got, err := YearFraction(test.dc, test.start, test.end, test.ref)
+		if err != nil {
+			t.Errorf("YearFraction(%s, %s, %s) failed: %v", test.dc, test.start.Format("2006-01-02"), test.end.Format("2006-01-02"), err)
+			continue
+		}
+		if math.Abs(got-test.want) > 1e-11 {
+			t.Errorf("YearFraction(%s, %s, %s) = %.12f, want %.12f", test.dc, test.start.Format("2006-01-02"), test.end.Format("2006-01-02"), got, test.want)
+		}
+	}
+}
+
+func TestYearFractionBus252WithoutCalendar(t *testing.T) {
+	start := time.Date(2025, time.January, 2, 0, 0, 0, 0, time.UTC)
+	if _, err := YearFraction(Bus252, start, start.AddDate(1, 0, 0), DayCountReference{}); err == nil {
+		t.Error("YearFraction(BUS/252) without a calendar did not fail")
+	}
+}
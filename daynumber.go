@@ -0,0 +1,20 @@
+package main
+
+import (
+	"time"
+)
+
+// secondsPerDay number of seconds in a calendar day
+const secondsPerDay = 24 * 60 * 60
+
+// dayNumber returns the number of days from 1970-01-01 to the calendar date
+// of t. Calendar conversions count days with it instead of time.Duration,
+// which overflows after about 292 years.
+func dayNumber(t time.Time) int {
+	return int(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix() / secondsPerDay)
+}
+
+// dateFromDayNumber returns the midnight UTC date of the day number n
+func dateFromDayNumber(n int) time.Time {
+	return time.Unix(int64(n)*secondsPerDay, 0).UTC()
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"time"
+)
+
+// isQuarterMonth reports whether the month is March, June, September or
+// December, the months of the IMM and CDS cycles
+func isQuarterMonth(mm time.Month) bool {
+	return mm%3 == 0
+}
+
+// IMMDate returns the IMM date of a month, its third Wednesday
+func IMMDate(yyyy int, mm time.Month) time.Time {
+	return returnNthWeekday(yyyy, mm, time.Wednesday, 3)
+}
+
+// NextIMMDate returns the first IMM date of March, June, September or
+// December after the date
+func NextIMMDate(date time.Time) time.Time {
+	for month := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC); ; month = month.AddDate(0, 1, 0) {
+		if !isQuarterMonth(month.Month()) {
+			continue
+		}
+		if imm := IMMDate(month.Year(), month.Month()); imm.After(date) {
+			return imm
+		}
+	}
+}
+
+// NextCDSDate returns the first CDS roll date, the 20th of March, June,
+// September or December, after the date. The dates are unadjusted; premium
+// payments move to the next business day with Adjust and Following.
+func NextCDSDate(date time.Time) time.Time {
+	for month := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC); ; month = month.AddDate(0, 1, 0) {
+		if !isQuarterMonth(month.Month()) {
+			continue
+		}
+		if roll := month.AddDate(0, 0, 19); roll.After(date) {
+			return roll
+		}
+	}
+}
+
+// OptionExpiry returns the expiry of the monthly equity options of a month,
+// the third Friday, or the business day before it when the exchange calendar
+// cal has a holiday on that Friday
+func OptionExpiry(cal HolidayCalendar, yyyy int, mm time.Month) time.Time {
+	return Adjust(cal, returnNthWeekday(yyyy, mm, time.Friday, 3), Preceding)
+}
+
+// VIXExpiry returns the final settlement date of the VIX futures and options
+// of a month: the Wednesday 30 days before the third Friday of the next
+// month, when the SPX options used for the settlement expire. When that
+// Friday is a holiday of cal, the 30 days count back from the business day
+// before it, and a holiday on the expiry moves it to the business day before.
+func VIXExpiry(cal HolidayCalendar, yyyy int, mm time.Month) time.Time {
+	next := time.Date(yyyy, mm+1, 1, 0, 0, 0, 0, time.UTC)
+	spx := OptionExpiry(cal, next.Year(), next.Month())
+	return Adjust(cal, spx.AddDate(0, 0, -30), Preceding)
+}
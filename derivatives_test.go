@@ -0,0 +1,71 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextIMMDate(t *testing.T) {
+	tests := []struct {
+		date, imm, cds time.Time
+	}{
+		{time.Date(2025, time.January, 10, 0, 0, 0, 0, time.UTC), time.Date(2025, time.March, 19, 0, 0, 0, 0, time.UTC), time.Date(2025, time.March, 20, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2025, time.March, 19, 0, 0, 0, 0, time.UTC), time.Date(2025, time.June, 18, 0, 0, 0, 0, time.UTC), time.Date(2025, time.March, 20, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2025, time.March, 20, 0, 0, 0, 0, time.UTC), time.Date(2025, time.June, 18, 0, 0, 0, 0, time.UTC), time.Date(2025, time.June, 20, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2025, time.December, 20, 0, 0, 0, 0, time.UTC), time.Date(2026, time.March, 18, 0, 0, 0, 0, time.UTC), time.Date(2026, time.March, 20, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		if got := NextIMMDate(test.date); !got.Equal(test.imm) {
+			t.Errorf("NextIMMDate(%s) = %s, want %s", test.date.Format("2006-01-02"), got.Format("2006-01-02"), test.imm.Format("2006-01-02"))
+		}
+		if got := NextCDSDate(test.date); !got.Equal(test.cds) {
+			t.Errorf("NextCDSDate(%s) = %s, want %s", test.date.Format("2006-01-02"), got.Format("2006-01-02"), test.cds.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestOptionExpiry(t *testing.T) {
+	cal := NewNYSECalendar()
+	tests := []struct {
+		month time.Time
+		want  time.Time
+	}{
+		{time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, time.January, 17, 0, 0, 0, 0, time.UTC)},
+		// Good Friday April 18
+		{time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, time.April, 17, 0, 0, 0, 0, time.UTC)},
+		// Juneteenth on Friday June 19
+		{time.Date(2026, time.June, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, time.June, 18, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		if got := OptionExpiry(cal, test.month.Year(), test.month.Month()); !got.Equal(test.want) {
+			t.Errorf("OptionExpiry(%s) = %s, want %s", test.month.Format("2006-01"), got.Format("2006-01-02"), test.want.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestVIXExpiry(t *testing.T) {
+	cal := NewNYSECalendar()
+	// the final settlement dates published by Cboe
+	want := []time.Time{
+		time.Date(2025, time.January, 22, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.February, 19, 0, 0, 0, 0, time.UTC),
+		// 30 days before Thursday April 17, as Good Friday is a holiday
+		time.Date(2025, time.March, 18, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.April, 16, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.May, 21, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.June, 18, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.July, 16, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.August, 20, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.September, 17, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.October, 22, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.November, 19, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.December, 17, 0, 0, 0, 0, time.UTC),
+		// 30 days before Thursday June 18, as Juneteenth is a holiday
+		time.Date(2026, time.May, 19, 0, 0, 0, 0, time.UTC),
+	}
+	for _, date := range want {
+		if got := VIXExpiry(cal, date.Year(), date.Month()); !got.Equal(date) {
+			t.Errorf("VIXExpiry(%s) = %s, want %s", date.Format("2006-01"), got.Format("2006-01-02"), date.Format("2006-01-02"))
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"time"
+)
+
+// mondayToThursday and mondayToFriday the weekdays of the early closes
+var (
+	mondayToThursday = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday}
+	mondayToFriday   = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+)
+
+// NewLSECalendar returns the trading calendar of the London Stock Exchange,
+// the bank holidays of England and Wales. The last weekdays before Christmas
+// and New Year's Day close at 12:30.
+func NewLSECalendar() *RuleCalendar {
+	halfDay := &SessionHours{Closes: 12*time.Hour + 30*time.Minute}
+	rules := append(englandBankHolidayRules(),
+		HolidayRule{Name: "Christmas Eve", Anchor: eveAnchor(time.December, 25, SundayToMonday), Hours: halfDay},
+		HolidayRule{Name: "New Year's Eve", Anchor: eveAnchor(time.January, 1, SundayToMonday), Hours: halfDay},
+	)
+	return newEnglandRuleCalendar("LSE", rules)
+}
+
+// NewXetraCalendar returns the trading calendar of Xetra and the Frankfurt
+// Stock Exchange, which Eurex follows for its German products. Xetra trades
+// on the German holidays other than New Year's Day, Easter and Labour Day,
+// and closes on Christmas Eve and New Year's Eve.
+func NewXetraCalendar() *RuleCalendar {
+	rules := []HolidayRule{
+		{Name: "Neujahr", Anchor: FixedDateAnchor(time.January, 1)},
+		{Name: "Karfreitag", Anchor: EasterAnchor(), Offset: -2},
+		{Name: "Ostermontag", Anchor: EasterAnchor(), Offset: 1},
+		{Name: "Tag der Arbeit", Anchor: FixedDateAnchor(time.May, 1)},
+		{Name: "Heiligabend", Anchor: FixedDateAnchor(time.December, 24)},
+		{Name: "1. Weihnachtstag", Anchor: FixedDateAnchor(time.December, 25)},
+		{Name: "2. Weihnachtstag", Anchor: FixedDateAnchor(time.December, 26)},
+		{Name: "Silvester", Anchor: FixedDateAnchor(time.December, 31)},
+	}
+	return NewRuleCalendar("Xetra", saturdaySundayWeekend, rules)
+}
+
+// NewASXCalendar returns the trading calendar of the Australian Securities
+// Exchange. New Year's Day, Australia Day, Christmas Day and Boxing Day on a
+// weekend give the next weekday off; Anzac Day does not. The last weekdays
+// before Christmas and New Year's Day close at 14:10.
+func NewASXCalendar() *RuleCalendar {
+	weekend := SubstituteOn(time.Saturday, time.Sunday)
+	earlyClose := &SessionHours{Closes: 14*time.Hour + 10*time.Minute}
+	rules := []HolidayRule{
+		{Name: "New Year's Day", Anchor: FixedDateAnchor(time.January, 1), Substitute: weekend},
+		{Name: "Australia Day", Anchor: FixedDateAnchor(time.January, 26), Substitute: weekend},
+		{Name: "Good Friday", Anchor: EasterAnchor(), Offset: -2},
+		{Name: "Easter Monday", Anchor: EasterAnchor(), Offset: 1},
+		{Name: "Anzac Day", Anchor: FixedDateAnchor(time.April, 25)},
+		{Name: "Queen's Birthday", Anchor: NthWeekdayAnchor(time.June, time.Monday, 2), LastYear: 2022},
+		{Name: "King's Birthday", Anchor: NthWeekdayAnchor(time.June, time.Monday, 2), FirstYear: 2023},
+		{Name: "Christmas Eve", Anchor: eveAnchor(time.December, 25, SundayToMonday), Hours: earlyClose},
+		{Name: "Christmas Day", Anchor: FixedDateAnchor(time.December, 25), Substitute: weekend},
+		{Name: "Boxing Day", Anchor: FixedDateAnchor(time.December, 26), Substitute: weekend},
+		{Name: "New Year's Eve", Anchor: eveAnchor(time.January, 1, SundayToMonday), Hours: earlyClose},
+	}
+	return NewRuleCalendar("ASX", saturdaySundayWeekend, rules)
+}
+
+// NewCMECalendar returns the holiday calendar of the CME Globex equity and
+// interest rate markets, in Chicago time. Globex closes on New Year's Day,
+// Good Friday and Christmas Day, and halts at 12:00 on the other US federal
+// holidays it trades, and at 12:15 on the trading days before Independence
+// Day and Christmas and on the day after Thanksgiving.
+func NewCMECalendar() *RuleCalendar {
+	noon := &SessionHours{Closes: 12 * time.Hour}
+	quarterPast := &SessionHours{Closes: 12*time.Hour + 15*time.Minute}
+	rules := []HolidayRule{
+		{Name: "New Year's Day", Anchor: FixedDateAnchor(time.January, 1), Observance: SundayToMonday},
+		{Name: "Birthday of Martin Luther King, Jr.", Anchor: NthWeekdayAnchor(time.January, time.Monday, 3), Hours: noon},
+		{Name: "Washington's Birthday", Anchor: NthWeekdayAnchor(time.February, time.Monday, 3), Hours: noon},
+		{Name: "Good Friday", Anchor: EasterAnchor(), Offset: -2},
+		{Name: "Memorial Day", Anchor: NthWeekdayAnchor(time.May, time.Monday, -1), Hours: noon},
+		{Name: "Juneteenth National Independence Day", Anchor: FixedDateAnchor(time.June, 19), FirstYear: 2022, Observance: NearestWorkday, Hours: noon},
+		{Name: "Day before Independence Day", Anchor: eveAnchor(time.July, 4, NearestWorkday), Hours: quarterPast},
+		{Name: "Independence Day", Anchor: FixedDateAnchor(time.July, 4), Observance: NearestWorkday, Hours: noon},
+		{Name: "Labor Day", Anchor: NthWeekdayAnchor(time.September, time.Monday, 1), Hours: noon},
+		{Name: "Thanksgiving Day", Anchor: thanksgivingAnchor, Hours: noon},
+		{Name: "Day after Thanksgiving", Anchor: thanksgivingAnchor, Offset: 1, Hours: quarterPast},
+		{Name: "Christmas Eve", Anchor: eveAnchor(time.December, 25, NearestWorkday), Hours: quarterPast},
+		{Name: "Christmas Day", Anchor: FixedDateAnchor(time.December, 25), Observance: NearestWorkday},
+	}
+	return NewRuleCalendar("CME", saturdaySundayWeekend, rules)
+}
+
+// NewTSXCalendar returns the trading calendar of the Toronto Stock Exchange,
+// the Ontario statutory holidays with the Civic Holiday. A holiday on a
+// weekend gives the next weekday off. Christmas Eve closes at 13:00.
+func NewTSXCalendar() *RuleCalendar {
+	weekend := SubstituteOn(time.Saturday, time.Sunday)
+	rules := []HolidayRule{
+		{Name: "New Year's Day", Anchor: FixedDateAnchor(time.January, 1), Substitute: weekend},
+		{Name: "Family Day", Anchor: NthWeekdayAnchor(time.February, time.Monday, 3), FirstYear: 2008},
+		{Name: "Good Friday", Anchor: EasterAnchor(), Offset: -2},
+		// the last Monday before May 25
+		{Name: "Victoria Day", Anchor: WeekdayInWindowAnchor(time.Monday, time.May, 18, time.May, 24)},
+		{Name: "Canada Day", Anchor: FixedDateAnchor(time.July, 1), Substitute: weekend},
+		{Name: "Civic Holiday", Anchor: NthWeekdayAnchor(time.August, time.Monday, 1)},
+		{Name: "Labour Day", Anchor: NthWeekdayAnchor(time.September, time.Monday, 1)},
+		{Name: "Thanksgiving Day", Anchor: NthWeekdayAnchor(time.October, time.Monday, 2)},
+		{Name: "Christmas Eve", Anchor: weekdayAnchor(FixedDateAnchor(time.December, 24), mondayToFriday...), Hours: &SessionHours{Closes: 13 * time.Hour}},
+		{Name: "Christmas Day", Anchor: FixedDateAnchor(time.December, 25), Substitute: weekend},
+		{Name: "Boxing Day", Anchor: FixedDateAnchor(time.December, 26), Substitute: weekend},
+	}
+	return NewRuleCalendar("TSX", saturdaySundayWeekend, rules)
+}
+
+// nyseAddedHolidays the days the New York Stock Exchange closed for weather,
+// the September 11 attacks and national days of mourning
+var nyseAddedHolidays = []Holiday{
+	{Name: "National Day of Mourning for President Richard Nixon", Date: time.Date(1994, time.April, 27, 0, 0, 0, 0, time.UTC)},
+	{Name: "September 11 Attacks", Date: time.Date(2001, time.September, 11, 0, 0, 0, 0, time.UTC)},
+	{Name: "September 11 Attacks", Date: time.Date(2001, time.September, 12, 0, 0, 0, 0, time.UTC)},
+	{Name: "September 11 Attacks", Date: time.Date(2001, time.September, 13, 0, 0, 0, 0, time.UTC)},
+	{Name: "September 11 Attacks", Date: time.Date(2001, time.September, 14, 0, 0, 0, 0, time.UTC)},
+	{Name: "National Day of Mourning for President Ronald Reagan", Date: time.Date(2004, time.June, 11, 0, 0, 0, 0, time.UTC)},
+	{Name: "National Day of Mourning for President Gerald Ford", Date: time.Date(2007, time.January, 2, 0, 0, 0, 0, time.UTC)},
+	{Name: "Hurricane Sandy", Date: time.Date(2012, time.October, 29, 0, 0, 0, 0, time.UTC)},
+	{Name: "Hurricane Sandy", Date: time.Date(2012, time.October, 30, 0, 0, 0, 0, time.UTC)},
+	{Name: "National Day of Mourning for President George H. W. Bush", Date: time.Date(2018, time.December, 5, 0, 0, 0, 0, time.UTC)},
+	{Name: "National Day of Mourning for President Jimmy Carter", Date: time.Date(2025, time.January, 9, 0, 0, 0, 0, time.UTC)},
+}
+
+// NewNYSECalendar returns the trading calendar of the New York Stock
+// Exchange. A holiday on a Saturday closes the Friday before, except New
+// Year's Day. The exchange closes at 13:00 on the day before Independence
+// Day, the day after Thanksgiving and Christmas Eve.
+func NewNYSECalendar() *RuleCalendar {
+	earlyClose := &SessionHours{Closes: 13 * time.Hour}
+	rules := []HolidayRule{
+		{Name: "New Year's Day", Anchor: FixedDateAnchor(time.January, 1), Observance: SundayToMonday},
+		{Name: "Birthday of Martin Luther King, Jr.", Anchor: NthWeekdayAnchor(time.January, time.Monday, 3), FirstYear: 1998},
+		{Name: "Washington's Birthday", Anchor: NthWeekdayAnchor(time.February, time.Monday, 3)},
+		{Name: "Good Friday", Anchor: EasterAnchor(), Offset: -2},
+		{Name: "Memorial Day", Anchor: NthWeekdayAnchor(time.May, time.Monday, -1)},
+		{Name: "Juneteenth National Independence Day", Anchor: FixedDateAnchor(time.June, 19), FirstYear: 2022, Observance: NearestWorkday},
+		{Name: "Day before Independence Day", Anchor: weekdayAnchor(FixedDateAnchor(time.July, 3), mondayToThursday...), Hours: earlyClose},
+		{Name: "Independence Day", Anchor: FixedDateAnchor(time.July, 4), Observance: NearestWorkday},
+		{Name: "Labor Day", Anchor: NthWeekdayAnchor(time.September, time.Monday, 1)},
+		{Name: "Thanksgiving Day", Anchor: thanksgivingAnchor},
+		{Name: "Day after Thanksgiving", Anchor: thanksgivingAnchor, Offset: 1, Hours: earlyClose},
+		{Name: "Christmas Eve", Anchor: weekdayAnchor(FixedDateAnchor(time.December, 24), mondayToThursday...), Hours: earlyClose},
+		{Name: "Christmas Day", Anchor: FixedDateAnchor(time.December, 25), Observance: NearestWorkday},
+	}
+	cal := NewRuleCalendar("NYSE", saturdaySundayWeekend, rules)
+	for _, holiday := range nyseAddedHolidays {
+		cal.AddHoliday(holiday.Name, holiday.Date)
+	}
+	return cal
+}
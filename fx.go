@@ -0,0 +1,168 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// fxCurrencyCalendars the settlement calendars of the currencies that are
+// not settled in CLS
+var fxCurrencyCalendars = map[string]func() HolidayCalendar{
+	"RUB": func() HolidayCalendar { return NewRussiaCalendar(nil) },
+	"TRY": func() HolidayCalendar { return NewTurkeyCalendar(nil) },
+	"BRL": func() HolidayCalendar { return NewBrazilCalendar() },
+	"INR": func() HolidayCalendar { return NewIndiaCalendar(nil, nil) },
+	"SAR": func() HolidayCalendar { return NewSaudiArabiaCalendar(nil) },
+	"AED": func() HolidayCalendar { return NewUAECalendar(nil) },
+}
+
+// fxSpotNextDay the currencies that settle spot one business day after the
+// trade against the dollar
+var fxSpotNextDay = map[string]bool{"CAD": true, "TRY": true, "RUB": true}
+
+// fxCalendars the currency calendars built so far, which keep their years
+var (
+	fxCalendars      = make(map[string]HolidayCalendar)
+	fxCalendarsMutex sync.Mutex
+)
+
+// currencyCalendar returns the settlement calendar of a currency
+func currencyCalendar(currency string) (HolidayCalendar, error) {
+	fxCalendarsMutex.Lock()
+	defer fxCalendarsMutex.Unlock()
+	if cal, found := fxCalendars[currency]; found {
+		return cal, nil
+	}
+	calendar, found := clsCurrencyCalendars[currency]
+	if !found {
+		calendar, found = fxCurrencyCalendars[currency]
+	}
+	if !found {
+		return nil, fmt.Errorf("no settlement calendar for currency %q", currency)
+	}
+	fxCalendars[currency] = calendar()
+	return fxCalendars[currency], nil
+}
+
+// fxPair the calendars of a currency pair such as "EURUSD" or "EUR/USD"
+type fxPair struct {
+	base, quote string
+	local       HolidayCalendar // the calendars of the currencies other than the dollar
+	all         HolidayCalendar // the calendars of both currencies and the dollar
+	spotLag     int
+}
+
+// parseFXPair returns the calendars of the currency pair
+func parseFXPair(pair string) (fxPair, error) {
+	code := strings.ToUpper(strings.Replace(pair, "/", "", 1))
+	if len(code) != 6 || code[:3] == code[3:] {
+		return fxPair{}, fmt.Errorf("invalid currency pair %q", pair)
+	}
+	p := fxPair{base: code[:3], quote: code[3:], spotLag: 2}
+	usd, err := currencyCalendar("USD")
+	if err != nil {
+		return fxPair{}, err
+	}
+	var local []HolidayCalendar
+	for _, currency := range []string{p.base, p.quote} {
+		if currency == "USD" {
+			continue
+		}
+		cal, err := currencyCalendar(currency)
+		if err != nil {
+			return fxPair{}, err
+		}
+		local = append(local, cal)
+	}
+	if (p.base == "USD" && fxSpotNextDay[p.quote]) || (p.quote == "USD" && fxSpotNextDay[p.base]) {
+		p.spotLag = 1
+		local = append(local, usd)
+	}
+	p.local = NewJointCalendar("", local...)
+	p.all = NewJointCalendar("", append(local, usd)...)
+	return p, nil
+}
+
+// SpotDate returns the spot value date of a trade in the currency pair, such
+// as "EURUSD" or "EUR/USD". Spot is two business days of the currencies
+// after the trade, one day for the dollar against CAD, TRY and RUB.
+// A dollar holiday on the first day does not count against a two day spot,
+// but the spot date is always a business day of both currencies and the
+// dollar.
+func SpotDate(pair string, tradeDate time.Time) (time.Time, error) {
+	p, err := parseFXPair(pair)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return p.spotDate(tradeDate), nil
+}
+
+// spotDate returns the spot value date of a trade on the date
+func (p fxPair) spotDate(tradeDate time.Time) time.Time {
+	return Adjust(p.all, AddBusinessDays(p.local, tradeDate, p.spotLag), Following)
+}
+
+// isLastBusinessDay reports whether the date is the last business day of its
+// month
+func isLastBusinessDay(cal HolidayCalendar, date time.Time) bool {
+	return AddBusinessDays(cal, date, 1).Month() != date.Month()
+}
+
+// SettlementDate returns the value date of a tenor of the currency pair
+// traded on the date. TOD is the trade date and TOM the next business day;
+// ON, TN and SN are the far dates of the overnight, tom-next and spot-next
+// swaps, TN the business day after TOM; SP or SPOT is spot. Tenors of days, weeks, months and years, such as
+// 3D, 1W, 1M or 1Y, run from spot and move to the next business day; months
+// and years use modified following, and from a spot on the last business day
+// of a month they end on the last business day of the month.
+func SettlementDate(pair string, tradeDate time.Time, tenor string) (time.Time, error) {
+	p, err := parseFXPair(pair)
+	if err != nil {
+		return time.Time{}, err
+	}
+	tenor = strings.ToUpper(tenor)
+	switch tenor {
+	case "TOD":
+		return Adjust(p.all, tradeDate, Following), nil
+	case "TOM", "ON":
+		return AddBusinessDays(p.all, tradeDate, 1), nil
+	case "TN":
+		// the business day after TOM, which is spot unless the pair
+		// settles spot the next day
+		if p.spotLag == 1 {
+			return AddBusinessDays(p.all, AddBusinessDays(p.all, tradeDate, 1), 1), nil
+		}
+		return p.spotDate(tradeDate), nil
+	case "SP", "SPOT":
+		return p.spotDate(tradeDate), nil
+	case "SN":
+		return AddBusinessDays(p.all, p.spotDate(tradeDate), 1), nil
+	}
+	if len(tenor) < 2 {
+		return time.Time{}, fmt.Errorf("invalid tenor %q", tenor)
+	}
+	n, err := strconv.Atoi(tenor[:len(tenor)-1])
+	if err != nil || n <= 0 {
+		return time.Time{}, fmt.Errorf("invalid tenor %q", tenor)
+	}
+	spot := p.spotDate(tradeDate)
+	switch tenor[len(tenor)-1] {
+	case 'D':
+		return Adjust(p.all, spot.AddDate(0, 0, n), Following), nil
+	case 'W':
+		return Adjust(p.all, spot.AddDate(0, 0, 7*n), Following), nil
+	case 'M':
+	case 'Y':
+		n *= 12
+	default:
+		return time.Time{}, fmt.Errorf("invalid tenor %q", tenor)
+	}
+	date := AddMonths(spot, n, false)
+	if isLastBusinessDay(p.all, spot) {
+		return Adjust(p.all, returnMonthEnd(date), Preceding), nil
+	}
+	return Adjust(p.all, date, ModifiedFollowing), nil
+}
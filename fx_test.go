@@ -0,0 +1,80 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSpotDate(t *testing.T) {
+	date := func(yyyy int, mm time.Month, dd int) time.Time {
+		return time.Date(yyyy, mm, dd, 0, 0, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		pair      string
+		tradeDate time.Time
+		want      time.Time
+	}{
+		{"EURUSD", date(2024, time.July, 1), date(2024, time.July, 3)},
+		// July 4 is a dollar holiday on the first day, which does not count
+		// against a two day spot
+		{"EURUSD", date(2024, time.July, 3), date(2024, time.July, 5)},
+		{"USDJPY", date(2024, time.December, 27), date(2025, time.January, 6)},
+		// the dollar against the Canadian dollar settles the next business day
+		{"USDCAD", date(2024, time.July, 2), date(2024, time.July, 3)},
+		{"USD/CAD", date(2024, time.June, 28), date(2024, time.July, 2)},
+		{"USDCAD", date(2024, time.July, 3), date(2024, time.July, 5)},
+		{"USDTRY", date(2024, time.June, 3), date(2024, time.June, 4)},
+		{"EURCHF", date(2024, time.July, 31), date(2024, time.August, 5)},
+		{"AUDUSD", date(2024, time.August, 2), date(2024, time.August, 7)},
+		{"NZDUSD", date(2024, time.January, 25), date(2024, time.January, 30)},
+		{"USDHKD", date(2024, time.September, 16), date(2024, time.September, 19)},
+		{"USDSGD", date(2024, time.May, 20), date(2024, time.May, 23)},
+	}
+	for _, test := range tests {
+		got, err := SpotDate(test.pair, test.tradeDate)
+		if err != nil {
+			t.Errorf("SpotDate(%s, %s) failed: %v", test.pair, test.tradeDate.Format("2006-01-02"), err)
+			continue
+		}
+		if !got.Equal(test.want) {
+			t.Errorf("SpotDate(%s, %s) = %s, want %s", test.pair, test.tradeDate.Format("2006-01-02"), got.Format("2006-01-02"), test.want.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestSpotDateUnknownCurrency(t *testing.T) {
+	if _, err := SpotDate("USDXXX", time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)); err == nil {
+		t.Error("SpotDate(USDXXX) did not fail")
+	}
+}
+
+func TestSettlementDate(t *testing.T) {
+	date := func(yyyy int, mm time.Month, dd int) time.Time {
+		return time.Date(yyyy, mm, dd, 0, 0, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		pair      string
+		tradeDate time.Time
+		tenor     string
+		want      time.Time
+	}{
+		{"EURUSD", date(2024, time.July, 1), "TOM", date(2024, time.July, 2)},
+		{"EURUSD", date(2024, time.July, 1), "TN", date(2024, time.July, 3)},
+		{"EURUSD", date(2024, time.July, 1), "SN", date(2024, time.July, 5)},
+		{"USDCAD", date(2024, time.July, 2), "TOM", date(2024, time.July, 3)},
+		// a day after TOM over July 4, not the next day spot
+		{"USDCAD", date(2024, time.July, 2), "TN", date(2024, time.July, 5)},
+		{"USDCAD", date(2024, time.July, 2), "SPOT", date(2024, time.July, 3)},
+		{"USDCAD", date(2024, time.July, 2), "SN", date(2024, time.July, 5)},
+	}
+	for _, test := range tests {
+		got, err := SettlementDate(test.pair, test.tradeDate, test.tenor)
+		if err != nil {
+			t.Errorf("SettlementDate(%s, %s, %s) failed: %v", test.pair, test.tradeDate.Format("2006-01-02"), test.tenor, err)
+			continue
+		}
+		if !got.Equal(test.want) {
+			t.Errorf("SettlementDate(%s, %s, %s) = %s, want %s", test.pair, test.tradeDate.Format("2006-01-02"), test.tenor, got.Format("2006-01-02"), test.want.Format("2006-01-02"))
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"time"
+)
+
+// Hebrew months, numbered from Nisan. The civil year starts with Tishri, and
+// leap years have a thirteenth month, Adar II.
+const (
+	Nisan = 1 + iota
+	Iyyar
+	Sivan
+	Tammuz
+	Av
+	Elul
+	Tishri
+	Heshvan
+	Kislev
+	Tevet
+	Shevat
+	Adar // Adar I in leap years
+	AdarII
+)
+
+// hebrewEpoch day number of 1 Tishri 1 AM, October 7 3761 BCE in the Julian
+// calendar
+const hebrewEpoch = -2092590
+
+// HebrewDate a date in the Hebrew calendar
+type HebrewDate struct {
+	Year  int `json:"Year" yaml:"Year" bson:"Year"`
+	Month int `json:"Month" yaml:"Month" bson:"Month"`
+	Day   int `json:"Day" yaml:"Day" bson:"Day"`
+}
+
+// HebrewLeapYear reports whether the Hebrew year has 13 months, which is the
+// case in 7 years of the 19 year Metonic cycle
+func HebrewLeapYear(year int) bool {
+	return (7*year+1)%19 < 7
+}
+
+// hebrewElapsedDays returns the days from the epoch to the molad of Tishri of
+// the year, postponed when the molad falls on a Sunday, Wednesday or Friday
+// (lo ADU rosh) and when it falls at or after noon (molad zaken).
+func hebrewElapsedDays(year int) int {
+	months := (235*year - 234) / 19
+	parts := 12084 + 13753*months
+	days := 29*months + parts/25920
+	if (3*(days+1))%7 < 3 {
+		days++
+	}
+	return days
+}
+
+// hebrewYearLengthCorrection applies the two remaining postponements, which
+// keep the length of a year within 353-355 or 383-385 days
+func hebrewYearLengthCorrection(year int) int {
+	previous := hebrewElapsedDays(year - 1)
+	current := hebrewElapsedDays(year)
+	next := hebrewElapsedDays(year + 1)
+	switch {
+	case next-current == 356:
+		return 2
+	case current-previous == 382:
+		return 1
+	}
+	return 0
+}
+
+// hebrewNewYear returns the day number of Rosh Hashanah, 1 Tishri of the year
+func hebrewNewYear(year int) int {
+	return hebrewEpoch + hebrewElapsedDays(year) + hebrewYearLengthCorrection(year)
+}
+
+// hebrewMonthLength returns the number of days in the month of the year
+func hebrewMonthLength(year, month int) int {
+	yearLength := hebrewNewYear(year+1) - hebrewNewYear(year)
+	switch month {
+	case Iyyar, Tammuz, Elul, Tevet, AdarII:
+		return 29
+	case Adar:
+		if !HebrewLeapYear(year) {
+			return 29
+		}
+	case Heshvan:
+		// Heshvan has 30 days in complete years of 355 and 385 days
+		if yearLength%10 != 5 {
+			return 29
+		}
+	case Kislev:
+		// Kislev has 29 days in deficient years of 353 and 383 days
+		if yearLength%10 == 3 {
+			return 29
+		}
+	}
+	return 30
+}
+
+// hebrewLastMonth returns the last month of the year, Adar or Adar II
+func hebrewLastMonth(year int) int {
+	if HebrewLeapYear(year) {
+		return AdarII
+	}
+	return Adar
+}
+
+// HebrewToGregorian converts a Hebrew date to a Gregorian date
+func HebrewToGregorian(date HebrewDate) time.Time {
+	day := hebrewNewYear(date.Year) + date.Day - 1
+	if date.Month < Tishri {
+		// Nisan to Elul come after the months from Tishri to Adar
+		for month := Tishri; month <= hebrewLastMonth(date.Year); month++ {
+			day += hebrewMonthLength(date.Year, month)
+		}
+		for month := Nisan; month < date.Month; month++ {
+			day += hebrewMonthLength(date.Year, month)
+		}
+	} else {
+		for month := Tishri; month < date.Month; month++ {
+			day += hebrewMonthLength(date.Year, month)
+		}
+	}
+	return dateFromDayNumber(day)
+}
+
+// GregorianToHebrew converts a Gregorian date to a Hebrew date
+func GregorianToHebrew(date time.Time) HebrewDate {
+	day := dayNumber(date)
+	year := date.Year() + 3761
+	for hebrewNewYear(year) > day {
+		year--
+	}
+	month := Tishri
+	if day >= dayNumber(HebrewToGregorian(HebrewDate{Year: year, Month: Nisan, Day: 1})) {
+		month = Nisan
+	}
+	for day > dayNumber(HebrewToGregorian(HebrewDate{Year: year, Month: month, Day: hebrewMonthLength(year, month)})) {
+		month++
+		if month > hebrewLastMonth(year) {
+			month = Nisan
+		}
+	}
+	start := dayNumber(HebrewToGregorian(HebrewDate{Year: year, Month: month, Day: 1}))
+	return HebrewDate{Year: year, Month: month, Day: day - start + 1}
+}
+
+// HebrewAnchor anchors a holiday on a Hebrew date. AdarII stands for the Adar
+// in which Purim is kept, which in common years is the only Adar.
+func HebrewAnchor(month, day int) HolidayAnchor {
+	return func(yyyy int) []time.Time {
+		var dates []time.Time
+		// a Gregorian year overlaps the Hebrew years 3760 and 3761 years later
+		for year := yyyy + 3760; year <= yyyy+3761; year++ {
+			hebrewMonth := month
+			if month == AdarII && !HebrewLeapYear(year) {
+				hebrewMonth = Adar
+			}
+			if date := HebrewToGregorian(HebrewDate{Year: year, Month: hebrewMonth, Day: day}); date.Year() == yyyy {
+				dates = append(dates, date)
+			}
+		}
+		return dates
+	}
+}
+
+// TishaBAvAnchor anchors the fast of 9 Av, which is postponed to Sunday when
+// it falls on the Sabbath
+func TishaBAvAnchor() HolidayAnchor {
+	return func(yyyy int) []time.Time {
+		date := HebrewToGregorian(HebrewDate{Year: yyyy + 3760, Month: Av, Day: 9})
+		if date.Weekday() == time.Saturday {
+			date = date.AddDate(0, 0, 1)
+		}
+		return []time.Time{date}
+	}
+}
+
+// YomHaAtzmautAnchor anchors Israel's Independence Day on 5 Iyyar. It is
+// brought forward to Thursday when 5 Iyyar falls on Friday or Saturday, and
+// since 2004 it is postponed to Tuesday when 5 Iyyar falls on Monday, so that
+// Memorial Day on the eve does not follow the Sabbath.
+func YomHaAtzmautAnchor() HolidayAnchor {
+	return func(yyyy int) []time.Time {
+		date := HebrewToGregorian(HebrewDate{Year: yyyy + 3760, Month: Iyyar, Day: 5})
+		switch date.Weekday() {
+		case time.Friday:
+			date = date.AddDate(0, 0, -1)
+		case time.Saturday:
+			date = date.AddDate(0, 0, -2)
+		case time.Monday:
+			if yyyy >= 2004 {
+				date = date.AddDate(0, 0, 1)
+			}
+		}
+		return []time.Time{date}
+	}
+}
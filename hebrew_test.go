@@ -0,0 +1,33 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHebrewToGregorian(t *testing.T) {
+	tests := []struct {
+		date HebrewDate
+		want time.Time
+	}{
+		// Rosh Hashanah
+		{HebrewDate{Year: 5784, Month: Tishri, Day: 1}, time.Date(2023, time.September, 16, 0, 0, 0, 0, time.UTC)},
+		{HebrewDate{Year: 5785, Month: Tishri, Day: 1}, time.Date(2024, time.October, 3, 0, 0, 0, 0, time.UTC)},
+		{HebrewDate{Year: 5786, Month: Tishri, Day: 1}, time.Date(2025, time.September, 23, 0, 0, 0, 0, time.UTC)},
+		// Passover
+		{HebrewDate{Year: 5783, Month: Nisan, Day: 15}, time.Date(2023, time.April, 6, 0, 0, 0, 0, time.UTC)},
+		{HebrewDate{Year: 5784, Month: Nisan, Day: 15}, time.Date(2024, time.April, 23, 0, 0, 0, 0, time.UTC)},
+		{HebrewDate{Year: 5785, Month: Nisan, Day: 15}, time.Date(2025, time.April, 13, 0, 0, 0, 0, time.UTC)},
+		// Yom Kippur
+		{HebrewDate{Year: 5785, Month: Tishri, Day: 10}, time.Date(2024, time.October, 12, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		got := HebrewToGregorian(test.date)
+		if !got.Equal(test.want) {
+			t.Errorf("HebrewToGregorian(%v) = %s, want %s", test.date, got.Format("2006-01-02"), test.want.Format("2006-01-02"))
+		}
+		if back := GregorianToHebrew(test.want); back != test.date {
+			t.Errorf("GregorianToHebrew(%s) = %v, want %v", test.want.Format("2006-01-02"), back, test.date)
+		}
+	}
+}
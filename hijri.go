@@ -0,0 +1,243 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// Hijri months
+const (
+	Muharram = 1 + iota
+	Safar
+	RabiAlAwwal
+	RabiAlThani
+	JumadaAlUla
+	JumadaAlAkhirah
+	Rajab
+	Shaban
+	Ramadan
+	Shawwal
+	DhuAlQadah
+	DhuAlHijjah
+)
+
+// hijriEpoch day number of 1 Muharram 1 AH, July 16 622 in the Julian calendar
+const hijriEpoch = -492148
+
+// HijriDate a date in the Islamic (Hijri) calendar
+type HijriDate struct {
+	Year  int `json:"Year" yaml:"Year" bson:"Year"`
+	Month int `json:"Month" yaml:"Month" bson:"Month"`
+	Day   int `json:"Day" yaml:"Day" bson:"Day"`
+}
+
+// HijriCalendar a variant of the Islamic calendar. The variants only differ in
+// the Gregorian date on which each month starts, since the actual start
+// depends on the sighting of the new crescent.
+type HijriCalendar interface {
+	// MonthStart returns the Gregorian date of the first day of the month
+	MonthStart(year, month int) time.Time
+}
+
+// HijriToGregorian converts a Hijri date to a Gregorian date
+func HijriToGregorian(cal HijriCalendar, date HijriDate) time.Time {
+	return cal.MonthStart(date.Year, date.Month).AddDate(0, 0, date.Day-1)
+}
+
+// GregorianToHijri converts a Gregorian date to a Hijri date
+func GregorianToHijri(cal HijriCalendar, date time.Time) HijriDate {
+	day := dayNumber(date)
+	// estimate the month from the mean lunation and correct the estimate
+	// with the month starts of the calendar
+	index := int(float64(day-hijriEpoch) / synodicMonth)
+	for day < dayNumber(cal.MonthStart(hijriYearMonth(index))) {
+		index--
+	}
+	for day >= dayNumber(cal.MonthStart(hijriYearMonth(index+1))) {
+		index++
+	}
+	year, month := hijriYearMonth(index)
+	return HijriDate{Year: year, Month: month, Day: day - dayNumber(cal.MonthStart(year, month)) + 1}
+}
+
+// hijriMonthIndex numbers the months consecutively from Muharram 1 AH
+func hijriMonthIndex(year, month int) int {
+	return (year-1)*12 + month - 1
+}
+
+// hijriYearMonth returns the year and month of a month index
+func hijriYearMonth(index int) (int, int) {
+	return index/12 + 1, index%12 + 1
+}
+
+// HijriAnchor anchors a holiday on a Hijri date, such as Eid al-Fitr on
+// 1 Shawwal. As the Hijri year is 11 days shorter than the Gregorian year
+// the anchor returns two dates in some Gregorian years.
+func HijriAnchor(cal HijriCalendar, month, day int) HolidayAnchor {
+	return func(yyyy int) []time.Time {
+		var dates []time.Time
+		first := GregorianToHijri(cal, time.Date(yyyy, time.January, 1, 0, 0, 0, 0, time.UTC)).Year
+		for year := first; year <= first+2; year++ {
+			if date := HijriToGregorian(cal, HijriDate{Year: year, Month: month, Day: day}); date.Year() == yyyy {
+				dates = append(dates, date)
+			}
+		}
+		return dates
+	}
+}
+
+// TabularHijri the arithmetic Islamic calendar with the civil epoch and the
+// common 2, 5, 7, 10, 13, 16, 18, 21, 24, 26, 29 leap year cycle. Odd months
+// have 30 days, even months 29, and Dhu al-Hijjah 30 in leap years.
+type TabularHijri struct{}
+
+// MonthStart returns the Gregorian date of the first day of the month
+func (TabularHijri) MonthStart(year, month int) time.Time {
+	// normalise months outside 1-12 into the neighbouring years
+	year, month = hijriYearMonth(hijriMonthIndex(year, month))
+	day := hijriEpoch + 354*(year-1) + (3+11*year)/30 + 29*(month-1) + month/2
+	return dateFromDayNumber(day)
+}
+
+// HijriMonthStart the Gregorian start date of a Hijri month, as published in
+// a calendar table or announced by the authorities after the moon sighting
+type HijriMonthStart struct {
+	Year  int
+	Month int
+	Start time.Time
+}
+
+// HijriTable a Hijri calendar looked up from a table of month start dates.
+// Months missing from the table are taken from the fallback calendar, so a
+// table of officially announced dates overrides a computed calendar.
+type HijriTable struct {
+	starts   map[int]int
+	fallback HijriCalendar
+}
+
+// NewHijriTable creates a table calendar from month start dates
+func NewHijriTable(fallback HijriCalendar, starts ...HijriMonthStart) *HijriTable {
+	table := &HijriTable{starts: make(map[int]int), fallback: fallback}
+	for _, start := range starts {
+		table.starts[hijriMonthIndex(start.Year, start.Month)] = dayNumber(start.Start)
+	}
+	return table
+}
+
+// MonthStart returns the Gregorian date of the first day of the month
+func (table *HijriTable) MonthStart(year, month int) time.Time {
+	if day, found := table.starts[hijriMonthIndex(year, month)]; found {
+		return dateFromDayNumber(day)
+	}
+	return table.fallback.MonthStart(year, month)
+}
+
+// LoadHijriMonthStarts reads month start dates from JSON, for example an
+// official Umm al-Qura table or the dates announced for the Eids:
+//
+//	[{"Year": 1446, "Month": 10, "Start": "2025-03-30"}]
+func LoadHijriMonthStarts(r io.Reader) ([]HijriMonthStart, error) {
+	var entries []struct {
+		Year  int
+		Month int
+		Start string
+	}
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, err
+	}
+	starts := make([]HijriMonthStart, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Month < Muharram || entry.Month > DhuAlHijjah {
+			return nil, fmt.Errorf("hijri month %d of %d is out of range", entry.Month, entry.Year)
+		}
+		start, err := time.Parse("2006-01-02", entry.Start)
+		if err != nil {
+			return nil, err
+		}
+		starts = append(starts, HijriMonthStart{Year: entry.Year, Month: entry.Month, Start: start})
+	}
+	return starts, nil
+}
+
+// The Umm al-Qura calendar of Saudi Arabia has, since 1420 AH, started a month
+// on the day after the 29th when on that evening in Mecca the conjunction
+// takes place before sunset and the Moon sets after the Sun.
+const (
+	ummAlQuraFirstYear = 1420
+	ummAlQuraLastYear  = 1500
+)
+
+// ummAlQuraEpoch day number of 1 Muharram 1420, April 17 1999
+const ummAlQuraEpoch = 10698
+
+// ummAlQuraMonthLengths the official Umm al-Qura calendar of 1420 to 1500 AH
+// as published by King Abdulaziz City for Science and Technology, one entry a
+// year. Bit m-1 is set when month m has 30 days, otherwise it has 29.
+var ummAlQuraMonthLengths = [ummAlQuraLastYear - ummAlQuraFirstYear + 1]uint16{
+	0xbd2, 0xbc4, 0xb89, 0xa95, 0x52d, 0x5ad, 0xb6a, 0x6d4, 0xdc9, 0xd92, // 1420
+	0xaa6, 0x956, 0x2ae, 0x56d, 0x36a, 0xb55, 0xaaa, 0x94d, 0x49d, 0x95d, // 1430
+	0x2ba, 0x5b5, 0x5aa, 0xd55, 0xa9a, 0x92e, 0x26e, 0x55d, 0xada, 0x6d4, // 1440
+	0x6a5, 0x54b, 0xa97, 0x54e, 0xaae, 0x5ac, 0xba9, 0xd92, 0xb25, 0x64b, // 1450
+	0xcab, 0x55a, 0xb55, 0x6d2, 0xea5, 0xe4a, 0xa95, 0x52d, 0xaad, 0x36c, // 1460
+	0x759, 0x6d2, 0x695, 0x52d, 0xa5b, 0x4ba, 0x9ba, 0x3b4, 0xb69, 0xb52, // 1470
+	0xaa6, 0x4b6, 0x96d, 0x2ec, 0x6d9, 0xeb2, 0xd54, 0xd2a, 0xa56, 0x4ae, // 1480
+	0x96d, 0xd6a, 0xb54, 0xb29, 0xa93, 0x52b, 0xa57, 0x536, 0xab5, 0x6aa, // 1490
+	0xe93, // 1500
+}
+
+var ummAlQuraOnce sync.Once
+var ummAlQuraStarts map[int]int
+
+// NewUmmAlQuraHijri returns the Umm al-Qura calendar. The month starts from
+// 1420 to 1500 AH are taken from the official table, the other years from
+// 1350 to 1600 AH are computed with the Umm al-Qura rule, and the rest fall
+// back to the tabular calendar. Use NewHijriTable to add the announced month
+// starts on top of it.
+func NewUmmAlQuraHijri() *HijriTable {
+	ummAlQuraOnce.Do(func() {
+		ummAlQuraStarts = astronomicalMonthStarts(1350, 1600, Mecca, func(moon moonAtSunset) bool {
+			return moon.afterConjunction() && moon.setsAfterSun()
+		})
+		start := ummAlQuraEpoch
+		for i, lengths := range ummAlQuraMonthLengths {
+			for month := Muharram; month <= DhuAlHijjah; month++ {
+				ummAlQuraStarts[hijriMonthIndex(ummAlQuraFirstYear+i, month)] = start
+				start += 29 + int(lengths>>(month-1)&1)
+			}
+		}
+	})
+	return &HijriTable{starts: ummAlQuraStarts, fallback: TabularHijri{}}
+}
+
+// NewCrescentHijri returns an estimate of a calendar whose months start after
+// the new crescent is sighted by the observer, for the years 1350 to 1500 AH.
+// The visibility is predicted from the moon phases with the Yallop criterion,
+// real sightings can differ by a day.
+func NewCrescentHijri(observer Observer) *HijriTable {
+	starts := astronomicalMonthStarts(1350, 1500, observer, moonAtSunset.crescentVisible)
+	return &HijriTable{starts: starts, fallback: TabularHijri{}}
+}
+
+// astronomicalMonthStarts tabulates the month starts of the years first to
+// last. Every month lasts 29 days when newMonth holds on the evening of the
+// 29th, and 30 days otherwise.
+func astronomicalMonthStarts(first, last int, observer Observer, newMonth func(moonAtSunset) bool) map[int]int {
+	starts := make(map[int]int)
+	// begin a year early with the tabular calendar, which is at most a day
+	// or two off, and let the rule bring the month starts in line
+	start := dayNumber(TabularHijri{}.MonthStart(first-1, Muharram))
+	for index := hijriMonthIndex(first-1, Muharram); index <= hijriMonthIndex(last, DhuAlHijjah); index++ {
+		if index >= hijriMonthIndex(first, Muharram) {
+			starts[index] = start
+		}
+		if newMonth(observer.moonAtSunset(dateFromDayNumber(start + 28))) {
+			start += 29
+		} else {
+			start += 30
+		}
+	}
+	return starts
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUmmAlQuraMonthStart(t *testing.T) {
+	tests := []struct {
+		year, month int
+		want        time.Time
+	}{
+		// the published Umm al-Qura calendar
+		{1420, Muharram, time.Date(1999, time.April, 17, 0, 0, 0, 0, time.UTC)},
+		{1444, Ramadan, time.Date(2023, time.March, 23, 0, 0, 0, 0, time.UTC)},
+		{1444, Shawwal, time.Date(2023, time.April, 21, 0, 0, 0, 0, time.UTC)},
+		{1445, Muharram, time.Date(2023, time.July, 19, 0, 0, 0, 0, time.UTC)},
+		{1445, Ramadan, time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC)},
+		{1445, Shawwal, time.Date(2024, time.April, 10, 0, 0, 0, 0, time.UTC)},
+		{1445, DhuAlHijjah, time.Date(2024, time.June, 7, 0, 0, 0, 0, time.UTC)},
+		{1446, Muharram, time.Date(2024, time.July, 7, 0, 0, 0, 0, time.UTC)},
+		{1446, Ramadan, time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		{1446, Shawwal, time.Date(2025, time.March, 30, 0, 0, 0, 0, time.UTC)},
+		{1500, DhuAlHijjah, time.Date(2077, time.October, 18, 0, 0, 0, 0, time.UTC)},
+	}
+	cal := NewUmmAlQuraHijri()
+	for _, test := range tests {
+		if got := cal.MonthStart(test.year, test.month); !got.Equal(test.want) {
+			t.Errorf("MonthStart(%d, %d) = %s, want %s", test.year, test.month, got.Format("2006-01-02"), test.want.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestGregorianToHijri(t *testing.T) {
+	cal := NewUmmAlQuraHijri()
+	want := HijriDate{Year: 1446, Month: Ramadan, Day: 29}
+	if got := GregorianToHijri(cal, time.Date(2025, time.March, 29, 0, 0, 0, 0, time.UTC)); got != want {
+		t.Errorf("GregorianToHijri(2025-03-29) = %v, want %v", got, want)
+	}
+}
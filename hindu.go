@@ -0,0 +1,141 @@
+package main
+
+import (
+	"math"
+	"time"
+
+	"github.com/soniakeys/meeus/julian"
+)
+
+// Hindu lunar months in the amanta reckoning, where a month runs from new
+// moon to new moon. A month is named after the sidereal sign the Sun enters
+// during it, Chaitra being the month in which the Sun enters Mesha (Aries).
+const (
+	Chaitra = 1 + iota
+	Vaishakha
+	Jyeshtha
+	Ashadha
+	Shravana
+	Bhadrapada
+	Ashvin
+	Kartika
+	Margashirsha
+	Pausha
+	Magha
+	Phalguna
+)
+
+// Tithis of note. Tithis 1 to 15 are the bright half of the month (shukla
+// paksha) ending with the full moon, 16 to 30 the dark half (krishna paksha)
+// ending with the new moon.
+const (
+	Purnima  = 15
+	Amavasya = 30
+)
+
+// Moments of the day on which festivals are decided, as the time after
+// midnight Indian Standard Time. A festival is kept on the day its tithi
+// prevails at the moment prescribed for it.
+const (
+	Sunrise   = 6*time.Hour + 30*time.Minute  // udaya
+	Midday    = 12 * time.Hour                // madhyahna
+	Afternoon = 14*time.Hour + 30*time.Minute // aparahna
+	Evening   = 18*time.Hour + 30*time.Minute // pradosha
+	Night     = 23*time.Hour + 30*time.Minute // nishita
+)
+
+// indiaStandardTime the zone in which the Hindu calendar days are reckoned
+var indiaStandardTime = time.FixedZone("UTC+5:30", 5*60*60+30*60)
+
+// Delhi the observer for the Islamic holidays of India
+var Delhi = Observer{Name: "Delhi", Latitude: 28.6139, Longitude: 77.2090, Location: indiaStandardTime}
+
+// lahiriAyanamsa returns the Lahiri (Chitrapaksha) ayanamsa in degrees, the
+// distance of the tropical from the sidereal zodiac used by the Indian
+// national ephemeris
+func lahiriAyanamsa(jde float64) float64 {
+	return 23.85282 + (jde-2451545)/365.25*50.2788/3600
+}
+
+// Tithi returns the lunar day, 1 to 30, at the instant t. Each tithi is the
+// time the Moon takes to gain 12 degrees of longitude on the Sun.
+func Tithi(t time.Time) int {
+	return int(lunarElongation(julian.TimeToJD(t))/12) + 1
+}
+
+// Nakshatra returns the lunar mansion, 1 (Ashvini) to 27 (Revati), the Moon
+// is in at the instant t
+func Nakshatra(t time.Time) int {
+	jde := julian.TimeToJD(t)
+	moon, _, _ := lunarPosition(jde)
+	return int(normalizeDegrees(moon-lahiriAyanamsa(jde))/(360.0/27)) + 1
+}
+
+// siderealSign returns the sidereal sign of the Sun, 0 (Mesha) to 11 (Meena)
+func siderealSign(jde float64) int {
+	return int(math.Floor(normalizeDegrees(apparentSolarLongitude(jde)-lahiriAyanamsa(jde)) / 30))
+}
+
+// hinduLunarMonth one amanta month, between two new moons
+type hinduLunarMonth struct {
+	start  float64 // julian ephemeris day of the new moon the month starts with
+	end    float64 // and of the new moon that ends it
+	month  int
+	adhika bool // intercalary month, in which the Sun does not change sign
+}
+
+// hinduLunarMonths returns the months that start from November of the year
+// before yyyy to the end of yyyy
+func hinduLunarMonths(yyyy int) []hinduLunarMonth {
+	var months []hinduLunarMonth
+	last := julian.TimeToJD(time.Date(yyyy+1, time.January, 1, 0, 0, 0, 0, time.UTC))
+	start := newMoonAfter(julian.TimeToJD(time.Date(yyyy-1, time.November, 1, 0, 0, 0, 0, time.UTC)))
+	for start < last {
+		end := newMoonAfter(start + 1)
+		sign := siderealSign(start)
+		months = append(months, hinduLunarMonth{
+			start:  start,
+			end:    end,
+			month:  (sign+1)%12 + 1,
+			adhika: siderealSign(end) == sign,
+		})
+		start = end
+	}
+	return months
+}
+
+// tithiDate returns the first day of the month on which the tithi prevails at
+// the moment at. When the tithi begins and ends between two such moments the
+// festival is kept on the day the tithi ended.
+func (m hinduLunarMonth) tithiDate(tithi int, at time.Duration) time.Time {
+	for day := localDate(julian.JDToTime(m.start), indiaStandardTime); ; day = day.AddDate(0, 0, 1) {
+		moment := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, indiaStandardTime).Add(at)
+		jde := julian.TimeToJD(moment)
+		switch {
+		case jde < m.start:
+			continue
+		case jde >= m.end:
+			return localDate(julian.JDToTime(m.end), indiaStandardTime)
+		case Tithi(moment) >= tithi:
+			return day
+		}
+	}
+}
+
+// TithiAnchor anchors a festival on a tithi of a Hindu lunar month, kept on
+// the day the tithi prevails at the moment at, for example Diwali on the
+// Amavasya of Ashvin in the evening. Intercalary (adhika) months are skipped.
+func TithiAnchor(month, tithi int, at time.Duration) HolidayAnchor {
+	return func(yyyy int) []time.Time {
+		var dates []time.Time
+		for _, m := range hinduLunarMonths(yyyy) {
+			if m.month != month || m.adhika {
+				continue
+			}
+			if date := m.tithiDate(tithi, at); date.Year() == yyyy {
+				dates = append(dates, date)
+			}
+		}
+		return dates
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTithiAnchor(t *testing.T) {
+	tests := []struct {
+		name   string
+		anchor HolidayAnchor
+		offset int
+		want   []time.Time
+	}{
+		// the central government gazette
+		{"Diwali", diwaliAnchor, 0, []time.Time{
+			time.Date(2019, time.October, 27, 0, 0, 0, 0, time.UTC),
+			time.Date(2020, time.November, 14, 0, 0, 0, 0, time.UTC),
+			time.Date(2021, time.November, 4, 0, 0, 0, 0, time.UTC),
+			time.Date(2022, time.October, 24, 0, 0, 0, 0, time.UTC),
+			time.Date(2023, time.November, 12, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, time.October, 31, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, time.October, 20, 0, 0, 0, 0, time.UTC),
+		}},
+		// Holika Dahan in 2023 was put off to March 7 by the Bhadra, which
+		// the tithi alone does not decide, so that year is left to the gazette
+		{"Holi", holiAnchor, 1, []time.Time{
+			time.Date(2019, time.March, 21, 0, 0, 0, 0, time.UTC),
+			time.Date(2020, time.March, 10, 0, 0, 0, 0, time.UTC),
+			time.Date(2021, time.March, 29, 0, 0, 0, 0, time.UTC),
+			time.Date(2022, time.March, 18, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, time.March, 25, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, time.March, 14, 0, 0, 0, 0, time.UTC),
+		}},
+		{"Janmashtami", janmashtamiAnchor, 0, []time.Time{
+			time.Date(2019, time.August, 24, 0, 0, 0, 0, time.UTC),
+			time.Date(2020, time.August, 12, 0, 0, 0, 0, time.UTC),
+			time.Date(2021, time.August, 30, 0, 0, 0, 0, time.UTC),
+			time.Date(2022, time.August, 19, 0, 0, 0, 0, time.UTC),
+			time.Date(2023, time.September, 7, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, time.August, 26, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, time.August, 16, 0, 0, 0, 0, time.UTC),
+		}},
+	}
+	for _, test := range tests {
+		for _, want := range test.want {
+			dates := test.anchor(want.Year())
+			if len(dates) != 1 {
+				t.Errorf("%s %d: got %d dates, want 1", test.name, want.Year(), len(dates))
+				continue
+			}
+			if got := dates[0].AddDate(0, 0, test.offset); !got.Equal(want) {
+				t.Errorf("%s %d = %s, want %s", test.name, want.Year(), got.Format("2006-01-02"), want.Format("2006-01-02"))
+			}
+		}
+	}
+}
+
+func TestNSECalendarDiwali(t *testing.T) {
+	cal := NewNSECalendar(nil, nil)
+	tests := []struct {
+		date time.Time
+		want bool
+	}{
+		{time.Date(2024, time.October, 31, 0, 0, 0, 0, time.UTC), false},
+		{time.Date(2024, time.November, 1, 0, 0, 0, 0, time.UTC), true},
+		{time.Date(2025, time.October, 20, 0, 0, 0, 0, time.UTC), false},
+		{time.Date(2025, time.October, 21, 0, 0, 0, 0, time.UTC), true},
+		{time.Date(2025, time.October, 22, 0, 0, 0, 0, time.UTC), true},
+	}
+	for _, test := range tests {
+		if got := cal.IsHoliday(test.date); got != test.want {
+			t.Errorf("IsHoliday(%s) = %t, want %t", test.date.Format("2006-01-02"), got, test.want)
+		}
+	}
+}
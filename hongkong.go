@@ -0,0 +1,36 @@
+package main
+
+import (
+	"time"
+)
+
+// hongKongHolidayRules the general holidays of the Holidays Ordinance, which
+// are also the bank holidays. A holiday on a Sunday gives the next day that is
+// not a holiday off.
+func hongKongHolidayRules() []HolidayRule {
+	sunday := SubstituteOn(time.Sunday)
+	return []HolidayRule{
+		{Name: "The first day of January", Anchor: FixedDateAnchor(time.January, 1), Substitute: sunday},
+		{Name: "Lunar New Year's Day", Anchor: LunarAnchor(1, 1, chinaStandardTime), Substitute: sunday},
+		{Name: "The second day of Lunar New Year", Anchor: LunarAnchor(1, 2, chinaStandardTime), Substitute: sunday},
+		{Name: "The third day of Lunar New Year", Anchor: LunarAnchor(1, 3, chinaStandardTime), Substitute: sunday},
+		{Name: "Ching Ming Festival", Anchor: SolarTermAnchor(Qingming, chinaStandardTime), Substitute: sunday},
+		{Name: "Good Friday", Anchor: EasterAnchor(), Offset: -2},
+		{Name: "Easter Monday", Anchor: EasterAnchor(), Offset: 1},
+		{Name: "Labour Day", Anchor: FixedDateAnchor(time.May, 1), FirstYear: 1999, Substitute: sunday},
+		{Name: "The Birthday of the Buddha", Anchor: LunarAnchor(4, 8, chinaStandardTime), FirstYear: 1999, Substitute: sunday},
+		{Name: "Tuen Ng Festival", Anchor: LunarAnchor(5, 5, chinaStandardTime), Substitute: sunday},
+		{Name: "Hong Kong Special Administrative Region Establishment Day", Anchor: FixedDateAnchor(time.July, 1), FirstYear: 1997, Substitute: sunday},
+		{Name: "The day following the Chinese Mid-Autumn Festival", Anchor: LunarAnchor(8, 15, chinaStandardTime), Offset: 1, Substitute: sunday},
+		{Name: "National Day", Anchor: FixedDateAnchor(time.October, 1), FirstYear: 1997, Substitute: sunday},
+		{Name: "Chung Yeung Festival", Anchor: LunarAnchor(9, 9, chinaStandardTime), Substitute: sunday},
+		{Name: "Christmas Day", Anchor: FixedDateAnchor(time.December, 25), Substitute: sunday},
+		{Name: "The first weekday after Christmas Day", Anchor: FixedDateAnchor(time.December, 26), Substitute: sunday},
+	}
+}
+
+// NewHongKongCalendar returns the bank holiday calendar of Hong Kong, on
+// which Hong Kong dollar payments settle
+func NewHongKongCalendar() *RuleCalendar {
+	return NewRuleCalendar("Hong Kong", saturdaySundayWeekend, hongKongHolidayRules())
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"math"
+	"time"
+
+	"github.com/soniakeys/meeus/julian"
+)
+
+// Observer a place on Earth from which the Sun and Moon are observed, used by
+// the calendars whose months start with a sighting of the new crescent
+type Observer struct {
+	Name      string
+	Latitude  float64 // degrees, north positive
+	Longitude float64 // degrees, east positive
+	Location  *time.Location
+}
+
+// Mecca the observer used by the Umm al-Qura calendar
+var Mecca = Observer{Name: "Mecca", Latitude: 21.4225, Longitude: 39.8262, Location: time.FixedZone("UTC+3", 3*60*60)}
+
+// sunsetAltitude standard altitude of the centre of the Sun at sunset, which
+// includes refraction and semidiameter (Meeus chapter 15)
+const sunsetAltitude = -0.8333
+
+// equatorialCoordinates converts ecliptic coordinates in degrees to right
+// ascension and declination in degrees
+func equatorialCoordinates(jde, longitude, latitude float64) (rightAscension, declination float64) {
+	t := (jde - 2451545) / 36525
+	obliquity := degreesToRadians(23.4392911 - 0.0130042*t)
+	l := degreesToRadians(longitude)
+	b := degreesToRadians(latitude)
+	rightAscension = math.Atan2(math.Sin(l)*math.Cos(obliquity)-math.Tan(b)*math.Sin(obliquity), math.Cos(l))
+	declination = math.Asin(math.Sin(b)*math.Cos(obliquity) + math.Cos(b)*math.Sin(obliquity)*math.Sin(l))
+	return normalizeDegrees(rightAscension * 180 / math.Pi), declination * 180 / math.Pi
+}
+
+// altitude returns the geometric altitude in degrees of a body with the given
+// ecliptic coordinates at the julian day jd as seen by the observer
+func (o Observer) altitude(jd, longitude, latitude float64) float64 {
+	rightAscension, declination := equatorialCoordinates(jd, longitude, latitude)
+	// mean sidereal time at Greenwich, Meeus chapter 12
+	t := (jd - 2451545) / 36525
+	siderealTime := 280.46061837 + 360.98564736629*(jd-2451545) + 0.000387933*t*t
+	hourAngle := degreesToRadians(siderealTime + o.Longitude - rightAscension)
+	phi := degreesToRadians(o.Latitude)
+	delta := degreesToRadians(declination)
+	return math.Asin(math.Sin(phi)*math.Sin(delta)+math.Cos(phi)*math.Cos(delta)*math.Cos(hourAngle)) * 180 / math.Pi
+}
+
+// sunAltitude returns the altitude of the Sun in degrees
+func (o Observer) sunAltitude(jd float64) float64 {
+	return o.altitude(jd, apparentSolarLongitude(jd), 0)
+}
+
+// Sunset returns the instant of sunset on the local date of the observer
+func (o Observer) Sunset(date time.Time) time.Time {
+	// start at local mean noon and step forward until the Sun is down
+	noon := time.Date(date.Year(), date.Month(), date.Day(), 12, 0, 0, 0, time.UTC)
+	before := julian.TimeToJD(noon) - o.Longitude/360
+	after := before
+	for step := 0; step < 18*6 && o.sunAltitude(after) > sunsetAltitude; step++ {
+		before = after
+		after += 1.0 / (24 * 6)
+	}
+	// and narrow the ten minute window down to the second
+	for after-before > 1.0/secondsPerDay {
+		middle := (before + after) / 2
+		if o.sunAltitude(middle) > sunsetAltitude {
+			before = middle
+		} else {
+			after = middle
+		}
+	}
+	return julian.JDToTime(after)
+}
+
+// moonAtSunset describes the young Moon seen at sunset
+type moonAtSunset struct {
+	sunset      time.Time
+	age         float64 // days since the last conjunction
+	altitude    float64 // geocentric altitude in degrees
+	parallax    float64 // horizontal parallax in degrees
+	elongation  float64 // angular distance from the Sun in degrees
+	arcOfVision float64 // altitude difference between the Moon and the Sun in degrees
+}
+
+// moonAtSunset returns the position of the Moon at sunset on the local date
+func (o Observer) moonAtSunset(date time.Time) moonAtSunset {
+	sunset := o.Sunset(date)
+	jd := julian.TimeToJD(sunset)
+	longitude, latitude, distance := lunarPosition(jd)
+	sun := apparentSolarLongitude(jd)
+	moon := moonAtSunset{
+		sunset:   sunset,
+		age:      jd - newMoonOnOrBefore(jd),
+		altitude: o.altitude(jd, longitude, latitude),
+		parallax: math.Asin(6378.14/distance) * 180 / math.Pi,
+	}
+	moon.elongation = math.Acos(math.Cos(degreesToRadians(latitude))*math.Cos(degreesToRadians(longitude-sun))) * 180 / math.Pi
+	moon.arcOfVision = moon.altitude - o.sunAltitude(jd)
+	return moon
+}
+
+// afterConjunction reports whether the conjunction that starts the new
+// lunation has taken place before sunset
+func (moon moonAtSunset) afterConjunction() bool {
+	return moon.age < synodicMonth/2
+}
+
+// setsAfterSun reports whether the Moon sets after the Sun, using the
+// standard altitude of the Moon 0.7275 parallax - 0.5667 degree
+func (moon moonAtSunset) setsAfterSun() bool {
+	return moon.altitude > 0.7275*moon.parallax-0.5667
+}
+
+// crescentVisible estimates if the crescent can be seen with the naked eye
+// using the q test of Yallop (NAO Technical Note 69), evaluated at sunset.
+func (moon moonAtSunset) crescentVisible() bool {
+	if !moon.afterConjunction() || !moon.setsAfterSun() {
+		return false
+	}
+	// crescent width in minutes of arc from the semidiameter of the Moon
+	semidiameter := 0.27245 * moon.parallax * 60
+	width := semidiameter * (1 - math.Cos(degreesToRadians(moon.elongation)))
+	q := (moon.arcOfVision - (11.8371 - 6.3226*width + 0.7319*width*width - 0.1018*width*width*width)) / 10
+	return q > -0.160
+}
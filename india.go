@@ -0,0 +1,96 @@
+package main
+
+import (
+	"time"
+)
+
+// Holidays of India kept on a Hindu lunar date
+var (
+	// Holi the day after Holika Dahan, the full moon of Phalguna at dusk
+	holiAnchor            = TithiAnchor(Phalguna, Purnima, Evening)
+	mahaShivaratriAnchor  = TithiAnchor(Magha, 29, Night)
+	ramNavamiAnchor       = TithiAnchor(Chaitra, 9, Midday)
+	mahavirJayantiAnchor  = TithiAnchor(Chaitra, 13, Sunrise)
+	buddhaPurnimaAnchor   = TithiAnchor(Vaishakha, Purnima, Sunrise)
+	janmashtamiAnchor     = TithiAnchor(Shravana, 23, Sunrise)
+	ganeshChaturthiAnchor = TithiAnchor(Bhadrapada, 4, Midday)
+	dussehraAnchor        = TithiAnchor(Ashvin, 10, Afternoon)
+	diwaliAnchor          = TithiAnchor(Ashvin, Amavasya, Evening)
+	balipratipadaAnchor   = TithiAnchor(Kartika, 1, Sunrise)
+	guruNanakAnchor       = TithiAnchor(Kartika, Purnima, Sunrise)
+)
+
+// NewIndiaCalendar returns the gazetted holidays of the central government
+// offices in Delhi. The Hindu festivals are computed from the tithi and the
+// Islamic holidays follow hijri, which defaults to a crescent sighting
+// estimate for Delhi. The dates published in the gazette, by holiday name,
+// take precedence over the computed ones in the years they cover.
+func NewIndiaCalendar(hijri HijriCalendar, gazetted map[string][]time.Time) *RuleCalendar {
+	if hijri == nil {
+		hijri = NewCrescentHijri(Delhi)
+	}
+	rules := []HolidayRule{
+		{Name: "Republic Day", Anchor: FixedDateAnchor(time.January, 26)},
+		{Name: "Holi", Anchor: holiAnchor, Offset: 1},
+		{Name: "Mahavir Jayanti", Anchor: mahavirJayantiAnchor},
+		{Name: "Good Friday", Anchor: EasterAnchor(), Offset: -2},
+		{Name: "Buddha Purnima", Anchor: buddhaPurnimaAnchor},
+		{Name: "Independence Day", Anchor: FixedDateAnchor(time.August, 15)},
+		{Name: "Janmashtami", Anchor: janmashtamiAnchor},
+		{Name: "Gandhi Jayanti", Anchor: FixedDateAnchor(time.October, 2)},
+		{Name: "Dussehra", Anchor: dussehraAnchor},
+		{Name: "Diwali", Anchor: diwaliAnchor},
+		{Name: "Guru Nanak Jayanti", Anchor: guruNanakAnchor},
+		{Name: "Christmas Day", Anchor: FixedDateAnchor(time.December, 25)},
+		{Name: "Id-ul-Fitr", Anchor: HijriAnchor(hijri, Shawwal, 1)},
+		{Name: "Id-ul-Zuha", Anchor: HijriAnchor(hijri, DhuAlHijjah, 10)},
+		{Name: "Muharram", Anchor: HijriAnchor(hijri, Muharram, 10)},
+		{Name: "Milad-un-Nabi", Anchor: HijriAnchor(hijri, RabiAlAwwal, 12)},
+	}
+	return NewRuleCalendar("India", saturdaySundayWeekend, announceDates(rules, gazetted))
+}
+
+// nseLaxmiPujanDays the Diwali Laxmi Pujan holidays announced by the NSE. The
+// exchange follows the Maharashtra almanacs, which keep Laxmi Pujan on the
+// later day when the Amavasya spans two evenings, a day after the gazetted
+// Diwali in 2024 and 2025.
+var nseLaxmiPujanDays = []time.Time{
+	time.Date(2019, time.October, 27, 0, 0, 0, 0, time.UTC),
+	time.Date(2020, time.November, 14, 0, 0, 0, 0, time.UTC),
+	time.Date(2021, time.November, 4, 0, 0, 0, 0, time.UTC),
+	time.Date(2022, time.October, 24, 0, 0, 0, 0, time.UTC),
+	time.Date(2023, time.November, 12, 0, 0, 0, 0, time.UTC),
+	time.Date(2024, time.November, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(2025, time.October, 21, 0, 0, 0, 0, time.UTC),
+}
+
+// NewNSECalendar returns the trading holidays of the National Stock Exchange
+// of India in Mumbai. The exchange announces its holidays for the year ahead;
+// pass them in announced, by holiday name, to override the computed dates.
+func NewNSECalendar(hijri HijriCalendar, announced map[string][]time.Time) *RuleCalendar {
+	if hijri == nil {
+		hijri = NewCrescentHijri(Delhi)
+	}
+	rules := []HolidayRule{
+		{Name: "Republic Day", Anchor: FixedDateAnchor(time.January, 26)},
+		{Name: "Mahashivratri", Anchor: mahaShivaratriAnchor},
+		{Name: "Holi", Anchor: holiAnchor, Offset: 1},
+		{Name: "Ram Navami", Anchor: ramNavamiAnchor},
+		{Name: "Mahavir Jayanti", Anchor: mahavirJayantiAnchor},
+		{Name: "Good Friday", Anchor: EasterAnchor(), Offset: -2},
+		{Name: "Dr. Baba Saheb Ambedkar Jayanti", Anchor: FixedDateAnchor(time.April, 14)},
+		{Name: "Maharashtra Day", Anchor: FixedDateAnchor(time.May, 1)},
+		{Name: "Independence Day", Anchor: FixedDateAnchor(time.August, 15)},
+		{Name: "Ganesh Chaturthi", Anchor: ganeshChaturthiAnchor},
+		{Name: "Mahatma Gandhi Jayanti", Anchor: FixedDateAnchor(time.October, 2)},
+		{Name: "Dussehra", Anchor: dussehraAnchor},
+		{Name: "Diwali Laxmi Pujan", Anchor: AnnouncedAnchor(diwaliAnchor, nseLaxmiPujanDays...)},
+		{Name: "Diwali Balipratipada", Anchor: balipratipadaAnchor},
+		{Name: "Guru Nanak Jayanti", Anchor: guruNanakAnchor},
+		{Name: "Christmas", Anchor: FixedDateAnchor(time.December, 25)},
+		{Name: "Id-ul-Fitr", Anchor: HijriAnchor(hijri, Shawwal, 1)},
+		{Name: "Bakri Id", Anchor: HijriAnchor(hijri, DhuAlHijjah, 10)},
+		{Name: "Muharram", Anchor: HijriAnchor(hijri, Muharram, 10)},
+	}
+	return NewRuleCalendar("NSE", saturdaySundayWeekend, announceDates(rules, announced))
+}
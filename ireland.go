@@ -0,0 +1,36 @@
+package main
+
+import (
+	"time"
+)
+
+// stBrigidAnchor the first Monday of February, or February 1 when that is a
+// Friday
+func stBrigidAnchor(yyyy int) []time.Time {
+	date := time.Date(yyyy, time.February, 1, 0, 0, 0, 0, time.UTC)
+	if date.Weekday() != time.Friday {
+		date = returnFirstMonday(yyyy, time.February)
+	}
+	return []time.Time{date}
+}
+
+// NewIrelandCalendar returns the public holiday calendar of Ireland. Good
+// Friday is not a public holiday. New Year's Day, St Patrick's Day, Christmas
+// Day and St Stephen's Day on a weekend give the next free weekday off, so a
+// weekend Christmas gives the Monday and Tuesday after.
+func NewIrelandCalendar() *RuleCalendar {
+	weekend := SubstituteOn(time.Saturday, time.Sunday)
+	rules := []HolidayRule{
+		{Name: "New Year's Day", Anchor: FixedDateAnchor(time.January, 1), Substitute: weekend},
+		{Name: "St Brigid's Day", Anchor: stBrigidAnchor, FirstYear: 2023},
+		{Name: "St Patrick's Day", Anchor: FixedDateAnchor(time.March, 17), Substitute: weekend},
+		{Name: "Easter Monday", Anchor: EasterAnchor(), Offset: 1},
+		{Name: "May Bank Holiday", Anchor: NthWeekdayAnchor(time.May, time.Monday, 1)},
+		{Name: "June Bank Holiday", Anchor: NthWeekdayAnchor(time.June, time.Monday, 1)},
+		{Name: "August Bank Holiday", Anchor: NthWeekdayAnchor(time.August, time.Monday, 1)},
+		{Name: "October Bank Holiday", Anchor: NthWeekdayAnchor(time.October, time.Monday, -1)},
+		{Name: "Christmas Day", Anchor: FixedDateAnchor(time.December, 25), Substitute: weekend},
+		{Name: "St Stephen's Day", Anchor: FixedDateAnchor(time.December, 26), Substitute: weekend},
+	}
+	return NewRuleCalendar("Ireland", saturdaySundayWeekend, rules)
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"time"
+)
+
+// israelHolidayRules the rest days of the Israeli public holiday calendar
+func israelHolidayRules() []HolidayRule {
+	return []HolidayRule{
+		{Name: "Pesach", Anchor: HebrewAnchor(Nisan, 15)},
+		{Name: "Shvi'i shel Pesach", Anchor: HebrewAnchor(Nisan, 21)},
+		{Name: "Yom HaAtzmaut", Anchor: YomHaAtzmautAnchor(), FirstYear: 1949},
+		{Name: "Shavuot", Anchor: HebrewAnchor(Sivan, 6)},
+		{Name: "Rosh Hashanah", Anchor: HebrewAnchor(Tishri, 1)},
+		{Name: "Rosh Hashanah", Anchor: HebrewAnchor(Tishri, 2)},
+		{Name: "Yom Kippur", Anchor: HebrewAnchor(Tishri, 10)},
+		{Name: "Sukkot", Anchor: HebrewAnchor(Tishri, 15)},
+		{Name: "Shemini Atzeret", Anchor: HebrewAnchor(Tishri, 22)},
+	}
+}
+
+// NewIsraelCalendar returns the Israeli public holiday calendar. The Israeli
+// weekend is Friday and Saturday.
+func NewIsraelCalendar() *RuleCalendar {
+	return NewRuleCalendar("Israel", Weekend(time.Friday, time.Saturday), israelHolidayRules())
+}
+
+// NewTASECalendar returns the trading calendar of the Tel Aviv Stock Exchange.
+// The exchange is closed on the public holidays and their eves, on Purim and
+// on Tisha B'Av. It traded Sunday to Thursday until it moved to a Monday to
+// Friday week on January 5 2026.
+func NewTASECalendar() *RuleCalendar {
+	weekend := WeekendSchedule{
+		{Days: []time.Weekday{time.Friday, time.Saturday}},
+		{From: time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC), Days: []time.Weekday{time.Saturday, time.Sunday}},
+	}
+	rules := append(israelHolidayRules(),
+		HolidayRule{Name: "Purim", Anchor: HebrewAnchor(AdarII, 14)},
+		HolidayRule{Name: "Erev Pesach", Anchor: HebrewAnchor(Nisan, 14)},
+		HolidayRule{Name: "Erev Shvi'i shel Pesach", Anchor: HebrewAnchor(Nisan, 20)},
+		HolidayRule{Name: "Erev Shavuot", Anchor: HebrewAnchor(Sivan, 5)},
+		HolidayRule{Name: "Tisha B'Av", Anchor: TishaBAvAnchor()},
+		HolidayRule{Name: "Erev Rosh Hashanah", Anchor: HebrewAnchor(Tishri, 1), Offset: -1},
+		HolidayRule{Name: "Erev Yom Kippur", Anchor: HebrewAnchor(Tishri, 9)},
+		HolidayRule{Name: "Erev Sukkot", Anchor: HebrewAnchor(Tishri, 14)},
+		HolidayRule{Name: "Erev Shemini Atzeret", Anchor: HebrewAnchor(Tishri, 21)},
+	)
+	return NewRuleCalendar("TASE", weekend, rules)
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"time"
+)
+
+// japanStandardTime the zone in which the equinox days are reckoned
+var japanStandardTime = time.FixedZone("UTC+9", 9*60*60)
+
+// japanAddedHolidays the holidays of the imperial succession of 2019
+var japanAddedHolidays = []Holiday{
+	{Name: "National Holiday", Date: time.Date(2019, time.April, 30, 0, 0, 0, 0, time.UTC)},
+	{Name: "Enthronement Day", Date: time.Date(2019, time.May, 1, 0, 0, 0, 0, time.UTC)},
+	{Name: "National Holiday", Date: time.Date(2019, time.May, 2, 0, 0, 0, 0, time.UTC)},
+	{Name: "Enthronement Ceremony Day", Date: time.Date(2019, time.October, 22, 0, 0, 0, 0, time.UTC)},
+}
+
+// japanCitizensHolidayAnchor the day between Respect for the Aged Day and
+// the autumnal equinox when they are two days apart
+func japanCitizensHolidayAnchor(yyyy int) []time.Time {
+	aged := returnNthWeekday(yyyy, time.September, time.Monday, 3)
+	if SolarTermDate(yyyy, AutumnEquinox, japanStandardTime).Sub(aged) != 48*time.Hour {
+		return nil
+	}
+	return []time.Time{aged.AddDate(0, 0, 1)}
+}
+
+// japanHolidayRules the national holidays of Japan since 2000. A holiday on
+// a Sunday gives the next day that is not a holiday off. The Olympic Games
+// moved Marine Day, Sports Day and Mountain Day in 2020 and 2021.
+func japanHolidayRules() []HolidayRule {
+	sunday := SubstituteOn(time.Sunday)
+	return []HolidayRule{
+		{Name: "New Year's Day", Anchor: FixedDateAnchor(time.January, 1), Substitute: sunday},
+		{Name: "Coming of Age Day", Anchor: NthWeekdayAnchor(time.January, time.Monday, 2)},
+		{Name: "National Foundation Day", Anchor: FixedDateAnchor(time.February, 11), Substitute: sunday},
+		{Name: "Emperor's Birthday", Anchor: FixedDateAnchor(time.February, 23), FirstYear: 2020, Substitute: sunday},
+		{Name: "Vernal Equinox Day", Anchor: SolarTermAnchor(SpringEquinox, japanStandardTime), Substitute: sunday},
+		{Name: "Greenery Day", Anchor: FixedDateAnchor(time.April, 29), LastYear: 2006, Substitute: sunday},
+		{Name: "Showa Day", Anchor: FixedDateAnchor(time.April, 29), FirstYear: 2007, Substitute: sunday},
+		{Name: "Constitution Memorial Day", Anchor: FixedDateAnchor(time.May, 3), Substitute: sunday},
+		{Name: "National Holiday", Anchor: FixedDateAnchor(time.May, 4), LastYear: 2006},
+		{Name: "Greenery Day", Anchor: FixedDateAnchor(time.May, 4), FirstYear: 2007, Substitute: sunday},
+		{Name: "Children's Day", Anchor: FixedDateAnchor(time.May, 5), Substitute: sunday},
+		{Name: "Marine Day", Anchor: FixedDateAnchor(time.July, 20), LastYear: 2002, Substitute: sunday},
+		{Name: "Marine Day", Anchor: AnnouncedAnchor(NthWeekdayAnchor(time.July, time.Monday, 3),
+			time.Date(2020, time.July, 23, 0, 0, 0, 0, time.UTC),
+			time.Date(2021, time.July, 22, 0, 0, 0, 0, time.UTC)), FirstYear: 2003},
+		{Name: "Mountain Day", Anchor: AnnouncedAnchor(FixedDateAnchor(time.August, 11),
+			time.Date(2020, time.August, 10, 0, 0, 0, 0, time.UTC),
+			time.Date(2021, time.August, 8, 0, 0, 0, 0, time.UTC)), FirstYear: 2016, Substitute: sunday},
+		{Name: "Respect for the Aged Day", Anchor: FixedDateAnchor(time.September, 15), LastYear: 2002, Substitute: sunday},
+		{Name: "Respect for the Aged Day", Anchor: NthWeekdayAnchor(time.September, time.Monday, 3), FirstYear: 2003},
+		{Name: "National Holiday", Anchor: japanCitizensHolidayAnchor, FirstYear: 2003},
+		{Name: "Autumnal Equinox Day", Anchor: SolarTermAnchor(AutumnEquinox, japanStandardTime), Substitute: sunday},
+		{Name: "Health and Sports Day", Anchor: NthWeekdayAnchor(time.October, time.Monday, 2), LastYear: 2019},
+		{Name: "Sports Day", Anchor: AnnouncedAnchor(NthWeekdayAnchor(time.October, time.Monday, 2),
+			time.Date(2020, time.July, 24, 0, 0, 0, 0, time.UTC),
+			time.Date(2021, time.July, 23, 0, 0, 0, 0, time.UTC)), FirstYear: 2020},
+		{Name: "Culture Day", Anchor: FixedDateAnchor(time.November, 3), Substitute: sunday},
+		{Name: "Labour Thanksgiving Day", Anchor: FixedDateAnchor(time.November, 23), Substitute: sunday},
+		{Name: "Emperor's Birthday", Anchor: FixedDateAnchor(time.December, 23), LastYear: 2018, Substitute: sunday},
+	}
+}
+
+// newJapanRuleCalendar returns a calendar of the Japanese holiday rules and
+// the holidays of the imperial succession
+func newJapanRuleCalendar(name string, rules []HolidayRule) *RuleCalendar {
+	cal := NewRuleCalendar(name, saturdaySundayWeekend, rules)
+	for _, holiday := range japanAddedHolidays {
+		cal.AddHoliday(holiday.Name, holiday.Date)
+	}
+	return cal
+}
+
+// NewJapanCalendar returns the national holiday calendar of Japan
+func NewJapanCalendar() *RuleCalendar {
+	return newJapanRuleCalendar("Japan", japanHolidayRules())
+}
+
+// bankJanuary2Anchor January 2, except when New Year's Day is a Sunday and the
+// 2nd is its substitute holiday already
+func bankJanuary2Anchor(yyyy int) []time.Time {
+	if time.Date(yyyy, time.January, 1, 0, 0, 0, 0, time.UTC).Weekday() == time.Sunday {
+		return nil
+	}
+	return []time.Time{time.Date(yyyy, time.January, 2, 0, 0, 0, 0, time.UTC)}
+}
+
+// japanBankHolidayRules the national holidays with the bank holidays from
+// December 31 to January 3
+func japanBankHolidayRules() []HolidayRule {
+	return append(japanHolidayRules(),
+		HolidayRule{Name: "Bank Holiday", Anchor: bankJanuary2Anchor},
+		HolidayRule{Name: "Bank Holiday", Anchor: FixedDateAnchor(time.January, 3)},
+		HolidayRule{Name: "Bank Holiday", Anchor: FixedDateAnchor(time.December, 31)},
+	)
+}
+
+// NewJPXCalendar returns the trading calendar of the Japan Exchange Group,
+// which closes on the bank holidays, from December 31 to January 3
+func NewJPXCalendar() *RuleCalendar {
+	return newJapanRuleCalendar("JPX", japanBankHolidayRules())
+}
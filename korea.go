@@ -0,0 +1,93 @@
+package main
+
+import (
+	"time"
+)
+
+// koreaStandardTime the zone in which the Korean lunar calendar is reckoned
+var koreaStandardTime = time.FixedZone("UTC+9", 9*60*60)
+
+// koreaAddedHolidays the election days and temporary holidays declared by the
+// government
+var koreaAddedHolidays = []Holiday{
+	{Name: "Temporary Holiday", Date: time.Date(2020, time.August, 17, 0, 0, 0, 0, time.UTC)},
+	{Name: "Presidential Election Day", Date: time.Date(2022, time.March, 9, 0, 0, 0, 0, time.UTC)},
+	{Name: "Local Election Day", Date: time.Date(2022, time.June, 1, 0, 0, 0, 0, time.UTC)},
+	{Name: "Temporary Holiday", Date: time.Date(2023, time.October, 2, 0, 0, 0, 0, time.UTC)},
+	{Name: "National Assembly Election Day", Date: time.Date(2024, time.April, 10, 0, 0, 0, 0, time.UTC)},
+	{Name: "Armed Forces Day", Date: time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC)},
+	{Name: "Temporary Holiday", Date: time.Date(2025, time.January, 27, 0, 0, 0, 0, time.UTC)},
+	{Name: "Presidential Election Day", Date: time.Date(2025, time.June, 3, 0, 0, 0, 0, time.UTC)},
+	{Name: "Local Election Day", Date: time.Date(2026, time.June, 3, 0, 0, 0, 0, time.UTC)},
+}
+
+// koreaHolidayRules the public holidays of South Korea. Substitute holidays
+// were introduced in 2014 for Seollal, Chuseok and Children's Day, on a
+// Sunday or a day shared with another holiday, extended to the national days
+// in 2021 and to Buddha's Birthday and Christmas in 2023, on a Saturday or
+// Sunday or a day shared with another holiday.
+func koreaHolidayRules() []HolidayRule {
+	sunday := SubstituteOn(time.Sunday)
+	weekend := SubstituteOn(time.Saturday, time.Sunday)
+	seollal := LunarAnchor(1, 1, koreaStandardTime)
+	buddha := LunarAnchor(4, 8, koreaStandardTime)
+	chuseok := LunarAnchor(8, 15, koreaStandardTime)
+	return []HolidayRule{
+		{Name: "New Year's Day", Anchor: FixedDateAnchor(time.January, 1)},
+		{Name: "Seollal", Anchor: seollal, Offset: -1, LastYear: 2013},
+		{Name: "Seollal", Anchor: seollal, LastYear: 2013},
+		{Name: "Seollal", Anchor: seollal, Offset: 1, LastYear: 2013},
+		{Name: "Seollal", Anchor: seollal, Offset: -1, FirstYear: 2014, Substitute: sunday},
+		{Name: "Seollal", Anchor: seollal, FirstYear: 2014, Substitute: sunday},
+		{Name: "Seollal", Anchor: seollal, Offset: 1, FirstYear: 2014, Substitute: sunday},
+		{Name: "Independence Movement Day", Anchor: FixedDateAnchor(time.March, 1), LastYear: 2020},
+		{Name: "Independence Movement Day", Anchor: FixedDateAnchor(time.March, 1), FirstYear: 2021, Substitute: weekend},
+		{Name: "Children's Day", Anchor: FixedDateAnchor(time.May, 5), LastYear: 2013},
+		{Name: "Children's Day", Anchor: FixedDateAnchor(time.May, 5), FirstYear: 2014, Substitute: weekend},
+		{Name: "Buddha's Birthday", Anchor: buddha, LastYear: 2022},
+		{Name: "Buddha's Birthday", Anchor: buddha, FirstYear: 2023, Substitute: weekend},
+		{Name: "Memorial Day", Anchor: FixedDateAnchor(time.June, 6)},
+		{Name: "Liberation Day", Anchor: FixedDateAnchor(time.August, 15), LastYear: 2020},
+		{Name: "Liberation Day", Anchor: FixedDateAnchor(time.August, 15), FirstYear: 2021, Substitute: weekend},
+		{Name: "Chuseok", Anchor: chuseok, Offset: -1, LastYear: 2013},
+		{Name: "Chuseok", Anchor: chuseok, LastYear: 2013},
+		{Name: "Chuseok", Anchor: chuseok, Offset: 1, LastYear: 2013},
+		{Name: "Chuseok", Anchor: chuseok, Offset: -1, FirstYear: 2014, Substitute: sunday},
+		{Name: "Chuseok", Anchor: chuseok, FirstYear: 2014, Substitute: sunday},
+		{Name: "Chuseok", Anchor: chuseok, Offset: 1, FirstYear: 2014, Substitute: sunday},
+		{Name: "National Foundation Day", Anchor: FixedDateAnchor(time.October, 3), LastYear: 2020},
+		{Name: "National Foundation Day", Anchor: FixedDateAnchor(time.October, 3), FirstYear: 2021, Substitute: weekend},
+		{Name: "Hangul Day", Anchor: FixedDateAnchor(time.October, 9), LastYear: 1990},
+		{Name: "Hangul Day", Anchor: FixedDateAnchor(time.October, 9), FirstYear: 2013, LastYear: 2020},
+		{Name: "Hangul Day", Anchor: FixedDateAnchor(time.October, 9), FirstYear: 2021, Substitute: weekend},
+		{Name: "Christmas Day", Anchor: FixedDateAnchor(time.December, 25), LastYear: 2022},
+		{Name: "Christmas Day", Anchor: FixedDateAnchor(time.December, 25), FirstYear: 2023, Substitute: weekend},
+	}
+}
+
+// newKoreaRuleCalendar returns a calendar of the Korean holiday rules and
+// the declared holidays
+func newKoreaRuleCalendar(name string, rules []HolidayRule) *RuleCalendar {
+	cal := NewRuleCalendar(name, saturdaySundayWeekend, rules)
+	for _, holiday := range koreaAddedHolidays {
+		cal.AddHoliday(holiday.Name, holiday.Date)
+	}
+	return cal
+}
+
+// NewKoreaCalendar returns the public holiday calendar of South Korea. Use
+// AddHoliday for election days and temporary holidays declared after the
+// built-in ones.
+func NewKoreaCalendar() *RuleCalendar {
+	return newKoreaRuleCalendar("South Korea", koreaHolidayRules())
+}
+
+// NewKRXCalendar returns the trading calendar of the Korea Exchange. The
+// exchange is also closed on Labour Day and on the last weekday of the year.
+func NewKRXCalendar() *RuleCalendar {
+	rules := append(koreaHolidayRules(),
+		HolidayRule{Name: "Labour Day", Anchor: FixedDateAnchor(time.May, 1)},
+		HolidayRule{Name: "Year-end Closing", Anchor: FixedDateAnchor(time.December, 31), Observance: PreviousWorkday},
+	)
+	return newKoreaRuleCalendar("KRX", rules)
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"math"
+	"time"
+
+	"github.com/soniakeys/meeus/julian"
+)
+
+// LunarDate a date in the Chinese lunisolar calendar, also kept in Korea and
+// Vietnam. Year is the Gregorian year in which the lunar year begins; Leap
+// marks the intercalary month that repeats the number of the month before it.
+type LunarDate struct {
+	Year  int  `json:"Year" yaml:"Year" bson:"Year"`
+	Month int  `json:"Month" yaml:"Month" bson:"Month"`
+	Day   int  `json:"Day" yaml:"Day" bson:"Day"`
+	Leap  bool `json:"Leap" yaml:"Leap" bson:"Leap"`
+}
+
+// lunarMonth one month of the lunisolar calendar
+type lunarMonth struct {
+	year  int
+	month int
+	leap  bool
+	start time.Time
+}
+
+// midnightJD returns the julian day of the start of the date in loc
+func midnightJD(date time.Time, loc *time.Location) float64 {
+	return julian.TimeToJD(time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc))
+}
+
+// newMoonDate returns the date in loc of the last new moon on or before date
+func newMoonDate(date time.Time, loc *time.Location) time.Time {
+	return localDate(julian.JDToTime(newMoonOnOrBefore(midnightJD(date.AddDate(0, 0, 1), loc))), loc)
+}
+
+// lunarSui returns the months from the 11th month, which holds the winter
+// solstice of the year before yyyy, up to the 11th month of yyyy, followed
+// by the start of that month. Months start on the day of the new moon in
+// loc. When the span has 13 months, the first month without a principal
+// solar term (zhongqi) is the leap month.
+func lunarSui(yyyy int, loc *time.Location) []lunarMonth {
+	first := newMoonDate(SolarTermDate(yyyy-1, WinterSolstice, loc), loc)
+	last := newMoonDate(SolarTermDate(yyyy, WinterSolstice, loc), loc)
+	starts := []time.Time{first}
+	for start := first; start.Before(last); {
+		start = localDate(julian.JDToTime(newMoonAfter(midnightJD(start.AddDate(0, 0, 1), loc))), loc)
+		starts = append(starts, start)
+	}
+	leapYear := len(starts) == 14
+	var months []lunarMonth
+	number, year := 10, yyyy-1
+	for i := 0; i+1 < len(starts); i++ {
+		if leapYear && !hasZhongqi(starts[i], starts[i+1], loc) {
+			leapYear = false
+			months = append(months, lunarMonth{year: year, month: number, leap: true, start: starts[i]})
+			continue
+		}
+		if number++; number > 12 {
+			number, year = 1, yyyy
+		}
+		months = append(months, lunarMonth{year: year, month: number, start: starts[i]})
+	}
+	return append(months, lunarMonth{year: yyyy, month: 11, start: last})
+}
+
+// hasZhongqi reports whether the Sun crosses a multiple of 30 degrees of
+// longitude between the dates start and end in loc
+func hasZhongqi(start, end time.Time, loc *time.Location) bool {
+	return math.Floor(apparentSolarLongitude(midnightJD(start, loc))/30) != math.Floor(apparentSolarLongitude(midnightJD(end, loc))/30)
+}
+
+// lunarMonths returns the months that start from the winter of the year
+// before yyyy to the winter of the year after
+func lunarMonths(yyyy int, loc *time.Location) []lunarMonth {
+	months := lunarSui(yyyy, loc)
+	return append(months[:len(months)-1], lunarSui(yyyy+1, loc)...)
+}
+
+// LunarToGregorian converts a lunar date reckoned in loc to a Gregorian date.
+// A leap month the year does not have is taken as the regular month.
+func LunarToGregorian(date LunarDate, loc *time.Location) time.Time {
+	var start time.Time
+	for _, month := range lunarMonths(date.Year, loc) {
+		if month.year == date.Year && month.month == date.Month && (month.leap == date.Leap || start.IsZero()) {
+			start = month.start
+		}
+	}
+	return start.AddDate(0, 0, date.Day-1)
+}
+
+// GregorianToLunar converts a Gregorian date to a lunar date reckoned in loc
+func GregorianToLunar(date time.Time, loc *time.Location) LunarDate {
+	var lunar LunarDate
+	for _, month := range lunarMonths(date.Year(), loc) {
+		if month.start.After(date) {
+			break
+		}
+		lunar = LunarDate{Year: month.year, Month: month.month, Day: dayNumber(date) - dayNumber(month.start) + 1, Leap: month.leap}
+	}
+	return lunar
+}
+
+// LunarAnchor anchors a holiday on a day of a regular lunar month reckoned
+// in loc, for example Chuseok on the 15th day of the 8th month
+func LunarAnchor(month, day int, loc *time.Location) HolidayAnchor {
+	return func(yyyy int) []time.Time {
+		// the 11th and 12th month of the lunar year before can fall in January
+		var dates []time.Time
+		for year := yyyy - 1; year <= yyyy; year++ {
+			if date := LunarToGregorian(LunarDate{Year: year, Month: month, Day: day}, loc); date.Year() == yyyy {
+				dates = append(dates, date)
+			}
+		}
+		return dates
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLunarToGregorian(t *testing.T) {
+	tests := []struct {
+		date LunarDate
+		loc  *time.Location
+		want time.Time
+	}{
+		// Seollal and Chuseok, Korea Astronomy and Space Science Institute
+		{LunarDate{Year: 2023, Month: 1, Day: 1}, koreaStandardTime, time.Date(2023, time.January, 22, 0, 0, 0, 0, time.UTC)},
+		{LunarDate{Year: 2024, Month: 1, Day: 1}, koreaStandardTime, time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)},
+		{LunarDate{Year: 2025, Month: 1, Day: 1}, koreaStandardTime, time.Date(2025, time.January, 29, 0, 0, 0, 0, time.UTC)},
+		{LunarDate{Year: 2026, Month: 1, Day: 1}, koreaStandardTime, time.Date(2026, time.February, 17, 0, 0, 0, 0, time.UTC)},
+		{LunarDate{Year: 2023, Month: 8, Day: 15}, koreaStandardTime, time.Date(2023, time.September, 29, 0, 0, 0, 0, time.UTC)},
+		{LunarDate{Year: 2024, Month: 8, Day: 15}, koreaStandardTime, time.Date(2024, time.September, 17, 0, 0, 0, 0, time.UTC)},
+		{LunarDate{Year: 2025, Month: 8, Day: 15}, koreaStandardTime, time.Date(2025, time.October, 6, 0, 0, 0, 0, time.UTC)},
+		{LunarDate{Year: 2026, Month: 8, Day: 15}, koreaStandardTime, time.Date(2026, time.September, 25, 0, 0, 0, 0, time.UTC)},
+		// Chinese New Year and the Mid-Autumn Festival
+		{LunarDate{Year: 2024, Month: 1, Day: 1}, chinaStandardTime, time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC)},
+		{LunarDate{Year: 2025, Month: 8, Day: 15}, chinaStandardTime, time.Date(2025, time.October, 6, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		got := LunarToGregorian(test.date, test.loc)
+		if !got.Equal(test.want) {
+			t.Errorf("LunarToGregorian(%v, %s) = %s, want %s", test.date, test.loc, got.Format("2006-01-02"), test.want.Format("2006-01-02"))
+		}
+		if back := GregorianToLunar(test.want, test.loc); back != test.date {
+			t.Errorf("GregorianToLunar(%s, %s) = %v, want %v", test.want.Format("2006-01-02"), test.loc, back, test.date)
+		}
+	}
+}
+
+func TestKoreaCalendarSeollal(t *testing.T) {
+	cal := NewKoreaCalendar()
+	// Seollal 2024 fell on a Saturday, with the substitute holiday on the
+	// Monday after the three days
+	for _, date := range []time.Time{
+		time.Date(2024, time.February, 9, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.February, 10, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.February, 11, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.February, 12, 0, 0, 0, 0, time.UTC),
+	} {
+		if !cal.IsHoliday(date) {
+			t.Errorf("IsHoliday(%s) = false, want true", date.Format("2006-01-02"))
+		}
+	}
+	if date := time.Date(2024, time.February, 13, 0, 0, 0, 0, time.UTC); cal.IsHoliday(date) {
+		t.Errorf("IsHoliday(%s) = true, want false", date.Format("2006-01-02"))
+	}
+}
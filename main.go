@@ -181,6 +181,14 @@ type Europe struct {
 type AsiaPacific struct {
 	AustrailianHolidays AustrailianHolidays `json:"AustrailianHolidays" yaml:"AustrailianHolidays" bson:"AustrailianHolidays"`
 	JapanBankHolidays   JapanBankHolidays   `json:"JapanBankHolidays" yaml:"JapanBankHolidays" bson:"JapanBankHolidays"`
+	NZHolidays          []Holiday           `json:"NZHolidays" yaml:"NZHolidays" bson:"NZHolidays"`
+}
+
+// Africa regional holidays
+type Africa struct {
+	ZAHolidays []Holiday `json:"ZAHolidays" yaml:"ZAHolidays" bson:"ZAHolidays"`
+	NGHolidays []Holiday `json:"NGHolidays" yaml:"NGHolidays" bson:"NGHolidays"`
+	KEHolidays []Holiday `json:"KEHolidays" yaml:"KEHolidays" bson:"KEHolidays"`
 }
 
 // Holidays Master holidays structure
@@ -189,35 +197,31 @@ type Holidays struct {
 	Americas    Americas    `json:"Americas" yaml:"Americas" bson:"Americas"`
 	Europe      Europe      `json:"Europe" yaml:"Europe" bson:"Europe"`
 	AsiaPacific AsiaPacific `json:"AsiaPacific" yaml:"AsiaPacific" bson:"AsiaPacific"`
+	Africa      Africa      `json:"Africa" yaml:"Africa" bson:"Africa"`
 }
 
 // IsWeekend Function to determine if the date falls on a weekend (SAT or SUN).
+// Calendars with a different or changing weekend use their WeekendSchedule.
 func IsWeekend(date time.Time) bool {
-	day := date.Weekday()
-	return day == time.Saturday || day == time.Sunday
+	return saturdaySundayWeekend.IsWeekend(date)
+}
+
+// returnNthWeekday returns the nth weekday of the month, or the nth from the
+// end of the month when n is negative, so -1 is the last one
+func returnNthWeekday(yyyy int, mm time.Month, weekday time.Weekday, n int) time.Time {
+	if n < 0 {
+		date := returnMonthEnd(time.Date(yyyy, mm, 1, 0, 0, 0, 0, time.UTC))
+		back := (int(date.Weekday()) - int(weekday) + 7) % 7
+		return date.AddDate(0, 0, 7*(n+1)-back)
+	}
+	date := time.Date(yyyy, mm, 1, 0, 0, 0, 0, time.UTC)
+	ahead := (int(weekday) - int(date.Weekday()) + 7) % 7
+	return date.AddDate(0, 0, ahead+7*(n-1))
 }
 
 // return3rdMonday function return the 3rd Monday of the Month
 func return3rdMonday(yyyy int, mm time.Month) time.Time {
-	date := time.Date(yyyy, mm, 1, 0, 0, 0, 0, time.UTC)
-	switch date.Weekday() {
-	case time.Tuesday:
-		return date.AddDate(0, 0, 20)
-	case time.Wednesday:
-		return date.AddDate(0, 0, 19)
-	case time.Thursday:
-		return date.AddDate(0, 0, 18)
-	case time.Friday:
-		return date.AddDate(0, 0, 17)
-	case time.Saturday:
-		return date.AddDate(0, 0, 16)
-	case time.Sunday:
-		return date.AddDate(0, 0, 16)
-	case time.Monday:
-		return date.AddDate(0, 0, 14)
-	}
-	//should never get here but go compiler wants a return after the case
-	return date
+	return returnNthWeekday(yyyy, mm, time.Monday, 3)
 }
 
 // returnMonthEnd reports the ending day of the month in t
@@ -226,98 +230,32 @@ func returnMonthEnd(date time.Time) time.Time {
 }
 
 func returnFirstMonday(yyyy int, mm time.Month) time.Time {
-	date := time.Date(yyyy, mm, 1, 0, 0, 0, 0, time.UTC)
-	switch date.Weekday() {
-	case time.Monday:
-		return date
-	case time.Tuesday:
-		return date.AddDate(0, 0, 6)
-	case time.Wednesday:
-		return date.AddDate(0, 0, 5)
-	case time.Thursday:
-		return date.AddDate(0, 0, 4)
-	case time.Friday:
-		return date.AddDate(0, 0, 3)
-	case time.Saturday:
-		return date.AddDate(0, 0, 2)
-	case time.Sunday:
-		return date.AddDate(0, 0, 1)
-	}
-	//should never get here but go compiler wants a return after the case
-	return date
+	return returnNthWeekday(yyyy, mm, time.Monday, 1)
 }
 
 // returnSecondMonday used to calculate Canadian Thanksgiving Day
 // amd US Columbus Day
 func returnSecondMonday(yyyy int, mm time.Month) time.Time {
-	return (returnFirstMonday(yyyy, mm)).AddDate(0, 0, 7)
+	return returnNthWeekday(yyyy, mm, time.Monday, 2)
 }
 
 // returnLastMonday Return the last Monday in the month
 func returnLastMonday(yyyy int, mm time.Month) time.Time {
-	date := returnMonthEnd(time.Date(yyyy, mm, 1, 0, 0, 0, 0, time.UTC))
-	switch date.Weekday() {
-	case time.Monday:
-		return date
-	case time.Tuesday:
-		return date.AddDate(0, 0, -1)
-	case time.Wednesday:
-		return date.AddDate(0, 0, -2)
-	case time.Thursday:
-		return date.AddDate(0, 0, -3)
-	case time.Friday:
-		return date.AddDate(0, 0, -4)
-	case time.Saturday:
-		return date.AddDate(0, 0, -5)
-	case time.Sunday:
-		return date.AddDate(0, 0, -6)
-	}
-	//should never get here but go compiler wants a return after the case
-	return date
+	return returnNthWeekday(yyyy, mm, time.Monday, -1)
 }
 
 func returnFourthThursday(yyyy int, mm time.Month) time.Time {
-	date := time.Date(yyyy, mm, 1, 0, 0, 0, 0, time.UTC)
-	switch date.Weekday() {
-	case time.Monday:
-		return date.AddDate(0, 0, 24)
-	case time.Tuesday:
-		return date.AddDate(0, 0, 23)
-	case time.Wednesday:
-		return date.AddDate(0, 0, 22)
-	case time.Thursday:
-		return date.AddDate(0, 0, 21)
-	case time.Friday:
-		return date.AddDate(0, 0, 20)
-	case time.Saturday:
-		return date.AddDate(0, 0, 19)
-	case time.Sunday:
-		return date.AddDate(0, 0, 18)
-	}
-	//should never get here but go compiler wants a return after the case
-	return date
+	return returnNthWeekday(yyyy, mm, time.Thursday, 4)
 }
 
+// returnObservableChristmas Christmas on a Saturday is observed Friday and on a Sunday Monday
 func returnObservableChristmas(yyyy int) time.Time {
-	date := time.Date(yyyy, time.December, 25, 0, 0, 0, 0, time.UTC)
-	switch date.Weekday() {
-	case time.Saturday:
-		return date.AddDate(0, 0, -1)
-	case time.Sunday:
-		return date.AddDate(0, 0, 1)
-	}
-	return date
+	return NearestWorkday(time.Date(yyyy, time.December, 25, 0, 0, 0, 0, time.UTC), saturdaySundayWeekend)
 }
 
+// returnObservableUSVeterandDay Veterans Day on a Saturday is observed Friday and on a Sunday Monday
 func returnObservableUSVeterandDay(yyyy int) time.Time {
-	date := time.Date(yyyy, time.November, 11, 0, 0, 0, 0, time.UTC)
-	switch date.Weekday() {
-	case time.Saturday:
-		return date.AddDate(0, 0, -1)
-	case time.Sunday:
-		return date.AddDate(0, 0, 1)
-	}
-	return date
+	return NearestWorkday(time.Date(yyyy, time.November, 11, 0, 0, 0, 0, time.UTC), saturdaySundayWeekend)
 }
 
 // calculateGregorianEaster Calculate Gregorian Calendar Easter date
@@ -377,28 +315,29 @@ func calculateGregorianEaster(year int) time.Time {
 	return time.Date(yyyy, time.April, dd, 0, 0, 0, 0, time.UTC)
 }
 
+// calculateGregorianEasterOffset the date days after easter, or before when days is negative.
+func calculateGregorianEasterOffset(year int, days int) time.Time {
+	return calculateGregorianEaster(year).AddDate(0, 0, days)
+}
+
 //calculateGregorianGoodFriday two days before easter.
 func calculateGregorianGoodFriday(year int) time.Time {
-	//calculate easter and subtract two days
-	return (calculateGregorianEaster(year)).AddDate(0, 0, -2)
+	return calculateGregorianEasterOffset(year, -2)
 }
 
 //calculateGregorianEasterMonday 1 days after easter.
 func calculateGregorianEasterMonday(year int) time.Time {
-	//calculate easter and add 1 day
-	return (calculateGregorianEaster(year)).AddDate(0, 0, 1)
+	return calculateGregorianEasterOffset(year, 1)
 }
 
 //calculateGregorianAscension 40 days after easter.
 func calculateGregorianAscension(year int) time.Time {
-	//calculate easter and add 40 days
-	return (calculateGregorianEaster(year)).AddDate(0, 0, 40)
+	return calculateGregorianEasterOffset(year, 40)
 }
 
 //calculateGregorianPentecost 50 days after easter.
 func calculateGregorianPentecost(year int) time.Time {
-	//calculate easter and add 40 days
-	return (calculateGregorianEaster(year)).AddDate(0, 0, 50)
+	return calculateGregorianEasterOffset(year, 50)
 }
 
 // inBetween : checks if i is between the min and the max returns boolean
@@ -419,14 +358,9 @@ func createUniqueFileString(n int) string {
 	return fmt.Sprintf("%X", b)
 }
 
+// setObservableHoliday moves a holiday on the weekend to the following Monday
 func setObservableHoliday(inDate time.Time, moveToMonday bool) time.Time {
-	if inDate.Weekday() == time.Saturday || inDate.Weekday() == time.Sunday {
-		if inDate.Weekday() == time.Saturday {
-			return inDate.AddDate(0, 0, 2)
-		}
-		return inDate.AddDate(0, 0, 1)
-	}
-	return inDate
+	return NextWorkday(inDate, saturdaySundayWeekend)
 }
 
 func setHolidayDE(yyyy int, h *Holidays) {
@@ -539,6 +473,27 @@ func setHolidaysECB(yyyy int, h *Holidays) {
 	waitGroup.Done()
 }
 
+// setHolidaysAfrica : set the South African, Nigerian and Kenyan holidays
+func setHolidaysAfrica(yyyy int, h *Holidays) {
+	h.Africa.ZAHolidays = NewSouthAfricaCalendar().Holidays(yyyy)
+	h.Africa.NGHolidays = NewNigeriaCalendar(nil).Holidays(yyyy)
+	h.Africa.KEHolidays = NewKenyaCalendar(nil).Holidays(yyyy)
+	fmt.Println("setHolidaysAfrica completed...")
+	waitGroup.Done()
+}
+
+// setHolidaysNZ : set the New Zealand national holidays
+func setHolidaysNZ(yyyy int, h *Holidays) {
+	cal, err := NewNewZealandCalendar("")
+	if err != nil {
+		log.Println(err)
+	} else {
+		h.AsiaPacific.NZHolidays = cal.Holidays(yyyy)
+	}
+	fmt.Println("setHolidaysNZ completed...")
+	waitGroup.Done()
+}
+
 // setHolidaysAustrailia : set the Austrailian Holidays -- assuming Australian Capital Territory rules
 func setHolidaysAustrailia(yyyy int, h *Holidays) {
 	// New Years day, if
@@ -739,13 +694,15 @@ func main() {
 
 	// use the waitGroup to track the code finishing.
 	{
-		waitGroup.Add(8)
+		waitGroup.Add(10)
 		go setHolidayYear(processYear, pointer2h)
 		go setHolidaysNL(processYear, pointer2h)
 		go setHolidayDE(processYear, pointer2h)
 		go setHolidaysUS(processYear, pointer2h)
 		go setHolidaysJapan(processYear, pointer2h)
 		go setHolidaysAustrailia(processYear, pointer2h)
+		go setHolidaysNZ(processYear, pointer2h)
+		go setHolidaysAfrica(processYear, pointer2h)
 		go setHolidaysUK(processYear, pointer2h)
 		go setHolidaysECB(processYear, pointer2h)
 		waitGroup.Wait()
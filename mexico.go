@@ -0,0 +1,57 @@
+package main
+
+import (
+	"time"
+)
+
+// mexicoInaugurationAnchor anchors the holiday of the presidential
+// inauguration, every six years on December 1 until 2018 and on October 1
+// from 2024
+func mexicoInaugurationAnchor(yyyy int) []time.Time {
+	switch {
+	case (yyyy-2000)%6 != 0:
+		return nil
+	case yyyy < 2024:
+		return []time.Time{time.Date(yyyy, time.December, 1, 0, 0, 0, 0, time.UTC)}
+	default:
+		return []time.Time{time.Date(yyyy, time.October, 1, 0, 0, 0, 0, time.UTC)}
+	}
+}
+
+// mexicoHolidayRules the mandatory rest days of the Ley Federal del Trabajo.
+// The 2006 reform moved Constitution Day to the first Monday of February and
+// the birthday of Benito Juárez and Revolution Day to the third Monday of
+// March and November, making long weekends (puentes).
+func mexicoHolidayRules() []HolidayRule {
+	return []HolidayRule{
+		{Name: "Año Nuevo", Anchor: FixedDateAnchor(time.January, 1)},
+		{Name: "Día de la Constitución", Anchor: FixedDateAnchor(time.February, 5), LastYear: 2005},
+		{Name: "Día de la Constitución", Anchor: NthWeekdayAnchor(time.February, time.Monday, 1), FirstYear: 2006},
+		{Name: "Natalicio de Benito Juárez", Anchor: FixedDateAnchor(time.March, 21), LastYear: 2005},
+		{Name: "Natalicio de Benito Juárez", Anchor: NthWeekdayAnchor(time.March, time.Monday, 3), FirstYear: 2006},
+		{Name: "Día del Trabajo", Anchor: FixedDateAnchor(time.May, 1)},
+		{Name: "Día de la Independencia", Anchor: FixedDateAnchor(time.September, 16)},
+		{Name: "Transmisión del Poder Ejecutivo Federal", Anchor: mexicoInaugurationAnchor},
+		{Name: "Día de la Revolución", Anchor: FixedDateAnchor(time.November, 20), LastYear: 2005},
+		{Name: "Día de la Revolución", Anchor: NthWeekdayAnchor(time.November, time.Monday, 3), FirstYear: 2006},
+		{Name: "Navidad", Anchor: FixedDateAnchor(time.December, 25)},
+	}
+}
+
+// NewMexicoCalendar returns the federal holiday calendar of Mexico
+func NewMexicoCalendar() *RuleCalendar {
+	return NewRuleCalendar("Mexico", saturdaySundayWeekend, mexicoHolidayRules())
+}
+
+// NewBMVCalendar returns the trading calendar of the Bolsa Mexicana de
+// Valores, which also closes with the banks on Holy Thursday, Good Friday,
+// the Day of the Dead and the day of the Virgin of Guadalupe
+func NewBMVCalendar() *RuleCalendar {
+	rules := append(mexicoHolidayRules(),
+		HolidayRule{Name: "Jueves Santo", Anchor: EasterAnchor(), Offset: -3},
+		HolidayRule{Name: "Viernes Santo", Anchor: EasterAnchor(), Offset: -2},
+		HolidayRule{Name: "Día de Muertos", Anchor: FixedDateAnchor(time.November, 2)},
+		HolidayRule{Name: "Día de la Virgen de Guadalupe", Anchor: FixedDateAnchor(time.December, 12)},
+	)
+	return NewRuleCalendar("BMV", saturdaySundayWeekend, rules)
+}
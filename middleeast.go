@@ -0,0 +1,63 @@
+package main
+
+import (
+	"time"
+)
+
+// NewUAECalendar returns the public holiday calendar of the United Arab
+// Emirates. The weekend moved from Friday and Saturday to Saturday and Sunday
+// on January 1 2022. The Friday half day of the government offices is not a
+// public holiday and is not part of the calendar.
+// The Islamic holidays follow hijri, which defaults to Umm al-Qura; pass a
+// HijriTable to apply the dates announced after the moon sighting.
+func NewUAECalendar(hijri HijriCalendar) *RuleCalendar {
+	if hijri == nil {
+		hijri = NewUmmAlQuraHijri()
+	}
+	weekend := WeekendSchedule{
+		{Days: []time.Weekday{time.Friday, time.Saturday}},
+		{From: time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC), Days: []time.Weekday{time.Saturday, time.Sunday}},
+	}
+	rules := []HolidayRule{
+		{Name: "New Year's Day", Anchor: FixedDateAnchor(time.January, 1)},
+		{Name: "Eid al-Fitr", Anchor: HijriAnchor(hijri, Shawwal, 1)},
+		{Name: "Eid al-Fitr", Anchor: HijriAnchor(hijri, Shawwal, 2)},
+		{Name: "Eid al-Fitr", Anchor: HijriAnchor(hijri, Shawwal, 3)},
+		{Name: "Arafat Day", Anchor: HijriAnchor(hijri, DhuAlHijjah, 9)},
+		{Name: "Eid al-Adha", Anchor: HijriAnchor(hijri, DhuAlHijjah, 10)},
+		{Name: "Eid al-Adha", Anchor: HijriAnchor(hijri, DhuAlHijjah, 11)},
+		{Name: "Eid al-Adha", Anchor: HijriAnchor(hijri, DhuAlHijjah, 12)},
+		{Name: "Islamic New Year", Anchor: HijriAnchor(hijri, Muharram, 1)},
+		{Name: "Prophet's Birthday", Anchor: HijriAnchor(hijri, RabiAlAwwal, 12)},
+		{Name: "Commemoration Day", Anchor: FixedDateAnchor(time.November, 30), FirstYear: 2015, LastYear: 2018},
+		{Name: "Commemoration Day", Anchor: FixedDateAnchor(time.December, 1), FirstYear: 2019},
+		{Name: "National Day", Anchor: FixedDateAnchor(time.December, 2)},
+		{Name: "National Day", Anchor: FixedDateAnchor(time.December, 3)},
+	}
+	return NewRuleCalendar("UAE", weekend, rules)
+}
+
+// NewSaudiArabiaCalendar returns the public holiday calendar of Saudi Arabia.
+// The weekend moved from Thursday and Friday to Friday and Saturday on June 29
+// 2013. The Islamic holidays follow hijri, which defaults to Umm al-Qura.
+func NewSaudiArabiaCalendar(hijri HijriCalendar) *RuleCalendar {
+	if hijri == nil {
+		hijri = NewUmmAlQuraHijri()
+	}
+	weekend := WeekendSchedule{
+		{Days: []time.Weekday{time.Thursday, time.Friday}},
+		{From: time.Date(2013, time.June, 29, 0, 0, 0, 0, time.UTC), Days: []time.Weekday{time.Friday, time.Saturday}},
+	}
+	rules := []HolidayRule{
+		{Name: "Founding Day", Anchor: FixedDateAnchor(time.February, 22), FirstYear: 2022},
+		{Name: "Eid al-Fitr", Anchor: HijriAnchor(hijri, Shawwal, 1)},
+		{Name: "Eid al-Fitr", Anchor: HijriAnchor(hijri, Shawwal, 2)},
+		{Name: "Eid al-Fitr", Anchor: HijriAnchor(hijri, Shawwal, 3)},
+		{Name: "Arafat Day", Anchor: HijriAnchor(hijri, DhuAlHijjah, 9)},
+		{Name: "Eid al-Adha", Anchor: HijriAnchor(hijri, DhuAlHijjah, 10)},
+		{Name: "Eid al-Adha", Anchor: HijriAnchor(hijri, DhuAlHijjah, 11)},
+		{Name: "Eid al-Adha", Anchor: HijriAnchor(hijri, DhuAlHijjah, 12)},
+		{Name: "National Day", Anchor: FixedDateAnchor(time.September, 23), FirstYear: 2005},
+	}
+	return NewRuleCalendar("Saudi Arabia", weekend, rules)
+}
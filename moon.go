@@ -0,0 +1,141 @@
+package main
+
+import (
+	"math"
+
+	"github.com/soniakeys/meeus/moonphase"
+)
+
+// synodicMonth mean length of a lunation in days
+const synodicMonth = 29.530588861
+
+// lunarTerm one periodic term of the lunar theory, Meeus chapter 47. The
+// multipliers are for the arguments D, M, M' and F.
+type lunarTerm struct {
+	d, m, mp, f float64
+	coefficient float64
+}
+
+// longitudeTerms the largest terms of Meeus table 47.A. The coefficients
+// are the sine terms of longitude in 0.000001 degree.
+var longitudeTerms = []lunarTerm{
+	{0, 0, 1, 0, 6288774}, {2, 0, -1, 0, 1274027}, {2, 0, 0, 0, 658314},
+	{0, 0, 2, 0, 213618}, {0, 1, 0, 0, -185116}, {0, 0, 0, 2, -114332},
+	{2, 0, -2, 0, 58793}, {2, -1, -1, 0, 57066}, {2, 0, 1, 0, 53322},
+	{2, -1, 0, 0, 45758}, {0, 1, -1, 0, -40923}, {1, 0, 0, 0, -34720},
+	{0, 1, 1, 0, -30383}, {2, 0, 0, -2, 15327}, {0, 0, 1, 2, -12528},
+	{0, 0, 1, -2, 10980}, {4, 0, -1, 0, 10675}, {0, 0, 3, 0, 10034},
+	{4, 0, -2, 0, 8548}, {2, 1, -1, 0, -7888}, {2, 1, 0, 0, -6766},
+	{1, 0, -1, 0, -5163}, {1, 1, 0, 0, 4987}, {2, -1, 1, 0, 4036},
+	{2, 0, 2, 0, 3994}, {4, 0, 0, 0, 3861}, {2, 0, -3, 0, 3665},
+	{0, 1, -2, 0, -2689}, {2, 0, -1, 2, -2602}, {2, -1, -2, 0, 2390},
+	{1, 0, 1, 0, -2348}, {2, -2, 0, 0, 2236}, {0, 1, 2, 0, -2120},
+	{0, 2, 0, 0, -2069},
+}
+
+// distanceTerms the largest cosine terms of the distance in Meeus table
+// 47.A, in 0.001 km
+var distanceTerms = []lunarTerm{
+	{0, 0, 1, 0, -20905355}, {2, 0, -1, 0, -3699111}, {2, 0, 0, 0, -2955968},
+	{0, 0, 2, 0, -569925}, {0, 1, 0, 0, 48888}, {2, 0, -2, 0, 246158},
+	{2, -1, -1, 0, -152138}, {2, 0, 1, 0, -170733}, {2, -1, 0, 0, -204586},
+	{0, 1, -1, 0, -129620}, {1, 0, 0, 0, 108743}, {0, 1, 1, 0, 104755},
+	{2, 0, 0, -2, 10321}, {0, 0, 1, -2, 79661}, {4, 0, -1, 0, -34782},
+	{0, 0, 3, 0, -23210}, {4, 0, -2, 0, -21636}, {2, 1, -1, 0, 24208},
+	{2, 1, 0, 0, 30824}, {1, 0, -1, 0, -8379}, {1, 1, 0, 0, -16675},
+	{2, -1, 1, 0, -12831}, {2, 0, 2, 0, -10445}, {4, 0, 0, 0, -11650},
+	{2, 0, -3, 0, 14403}, {0, 1, -2, 0, -7003}, {2, -1, -2, 0, 10056},
+	{1, 0, 1, 0, 6322}, {2, -2, 0, 0, -9884}, {0, 1, 2, 0, 5751},
+}
+
+// latitudeTerms the largest terms of Meeus table 47.B, in 0.000001 degree
+var latitudeTerms = []lunarTerm{
+	{0, 0, 0, 1, 5128122}, {0, 0, 1, 1, 280602}, {0, 0, 1, -1, 277693},
+	{2, 0, 0, -1, 173237}, {2, 0, -1, 1, 55413}, {2, 0, -1, -1, 46271},
+	{2, 0, 0, 1, 32573}, {0, 0, 2, 1, 17198}, {2, 0, 1, -1, 9266},
+	{0, 0, 2, -1, 8822}, {2, -1, 0, -1, 8216}, {2, 0, -2, -1, 4324},
+	{2, 0, 1, 1, 4200}, {2, 1, 0, -1, -3359}, {2, -1, -1, 1, 2463},
+}
+
+// lunarPosition returns the geocentric ecliptic longitude and latitude of the
+// Moon in degrees and its distance in km for the julian ephemeris day jde.
+// Only the largest terms of Meeus chapter 47 are used, which is good to
+// about 0.01 degree and plenty for finding the day of a lunar date.
+func lunarPosition(jde float64) (longitude, latitude, distance float64) {
+	t := (jde - 2451545) / 36525
+	lp := 218.3164477 + 481267.88123421*t - 0.0015786*t*t + t*t*t/538841
+	d := 297.8501921 + 445267.1114034*t - 0.0018819*t*t + t*t*t/545868
+	m := 357.5291092 + 35999.0502909*t - 0.0001536*t*t
+	mp := 134.9633964 + 477198.8675055*t + 0.0087414*t*t + t*t*t/69699
+	f := 93.2720950 + 483202.0175233*t - 0.0036539*t*t
+	a1 := 119.75 + 131.849*t
+	a2 := 53.09 + 479264.290*t
+	a3 := 313.45 + 481266.484*t
+	e := 1 - 0.002516*t - 0.0000074*t*t
+
+	argument := func(term lunarTerm) (float64, float64) {
+		factor := 1.0
+		switch math.Abs(term.m) {
+		case 1:
+			factor = e
+		case 2:
+			factor = e * e
+		}
+		return degreesToRadians(term.d*d + term.m*m + term.mp*mp + term.f*f), factor * term.coefficient
+	}
+	var sumL, sumR, sumB float64
+	for _, term := range longitudeTerms {
+		angle, coefficient := argument(term)
+		sumL += coefficient * math.Sin(angle)
+	}
+	for _, term := range distanceTerms {
+		angle, coefficient := argument(term)
+		sumR += coefficient * math.Cos(angle)
+	}
+	for _, term := range latitudeTerms {
+		angle, coefficient := argument(term)
+		sumB += coefficient * math.Sin(angle)
+	}
+	sumL += 3958*math.Sin(degreesToRadians(a1)) + 1962*math.Sin(degreesToRadians(lp-f)) +
+		318*math.Sin(degreesToRadians(a2))
+	sumB += -2235*math.Sin(degreesToRadians(lp)) + 382*math.Sin(degreesToRadians(a3)) +
+		175*math.Sin(degreesToRadians(a1-f)) + 175*math.Sin(degreesToRadians(a1+f)) +
+		127*math.Sin(degreesToRadians(lp-mp)) - 115*math.Sin(degreesToRadians(lp+mp))
+	return normalizeDegrees(lp + sumL/1e6), sumB / 1e6, 385000.56 + sumR/1000
+}
+
+// lunarElongation returns the longitude of the Moon east of the Sun in degrees,
+// from 0 at new moon through 180 at full moon.
+func lunarElongation(jde float64) float64 {
+	moon, _, _ := lunarPosition(jde)
+	return normalizeDegrees(moon - apparentSolarLongitude(jde))
+}
+
+// decimalYear converts a julian day to the decimal year moonphase works with
+func decimalYear(jde float64) float64 {
+	return 2000 + (jde-2451545)/365.25
+}
+
+// newMoonOnOrBefore returns the julian ephemeris day of the last new moon at
+// or before jde. moonphase returns the new moon of the lunation a decimal
+// year falls in, so sampling every half lunation finds all candidates.
+func newMoonOnOrBefore(jde float64) float64 {
+	found := math.Inf(-1)
+	for offset := -60.0; offset <= 15; offset += 14 {
+		if newMoon := moonphase.New(decimalYear(jde + offset)); newMoon <= jde && newMoon > found {
+			found = newMoon
+		}
+	}
+	return found
+}
+
+// newMoonAfter returns the julian ephemeris day of the first new moon after jde
+func newMoonAfter(jde float64) float64 {
+	found := math.Inf(1)
+	for offset := -15.0; offset <= 60; offset += 14 {
+		if newMoon := moonphase.New(decimalYear(jde + offset)); newMoon > jde && newMoon < found {
+			found = newMoon
+		}
+	}
+	return found
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// matarikiDates the dates of the Matariki public holiday in the schedule of
+// Te Kāhui o Matariki Public Holiday Act 2022
+var matarikiDates = []time.Time{
+	time.Date(2022, time.June, 24, 0, 0, 0, 0, time.UTC),
+	time.Date(2023, time.July, 14, 0, 0, 0, 0, time.UTC),
+	time.Date(2024, time.June, 28, 0, 0, 0, 0, time.UTC),
+	time.Date(2025, time.June, 20, 0, 0, 0, 0, time.UTC),
+	time.Date(2026, time.July, 10, 0, 0, 0, 0, time.UTC),
+	time.Date(2027, time.June, 25, 0, 0, 0, 0, time.UTC),
+	time.Date(2028, time.July, 14, 0, 0, 0, 0, time.UTC),
+	time.Date(2029, time.July, 6, 0, 0, 0, 0, time.UTC),
+	time.Date(2030, time.June, 21, 0, 0, 0, 0, time.UTC),
+	time.Date(2031, time.July, 11, 0, 0, 0, 0, time.UTC),
+	time.Date(2032, time.July, 2, 0, 0, 0, 0, time.UTC),
+	time.Date(2033, time.June, 24, 0, 0, 0, 0, time.UTC),
+	time.Date(2034, time.July, 7, 0, 0, 0, 0, time.UTC),
+	time.Date(2035, time.June, 29, 0, 0, 0, 0, time.UTC),
+	time.Date(2036, time.July, 18, 0, 0, 0, 0, time.UTC),
+	time.Date(2037, time.July, 10, 0, 0, 0, 0, time.UTC),
+	time.Date(2038, time.June, 25, 0, 0, 0, 0, time.UTC),
+	time.Date(2039, time.July, 15, 0, 0, 0, 0, time.UTC),
+	time.Date(2040, time.July, 6, 0, 0, 0, 0, time.UTC),
+	time.Date(2041, time.July, 19, 0, 0, 0, 0, time.UTC),
+	time.Date(2042, time.July, 11, 0, 0, 0, 0, time.UTC),
+	time.Date(2043, time.July, 3, 0, 0, 0, 0, time.UTC),
+	time.Date(2044, time.June, 24, 0, 0, 0, 0, time.UTC),
+	time.Date(2045, time.July, 7, 0, 0, 0, 0, time.UTC),
+	time.Date(2046, time.June, 29, 0, 0, 0, 0, time.UTC),
+	time.Date(2047, time.July, 19, 0, 0, 0, 0, time.UTC),
+	time.Date(2048, time.July, 3, 0, 0, 0, 0, time.UTC),
+	time.Date(2049, time.June, 25, 0, 0, 0, 0, time.UTC),
+	time.Date(2050, time.July, 15, 0, 0, 0, 0, time.UTC),
+	time.Date(2051, time.June, 30, 0, 0, 0, 0, time.UTC),
+	time.Date(2052, time.June, 21, 0, 0, 0, 0, time.UTC),
+}
+
+// nzLabourDayAnchor the fourth Monday of October
+var nzLabourDayAnchor = NthWeekdayAnchor(time.October, time.Monday, 4)
+
+// otagoAnniversaryAnchor the Monday nearest March 23, or the Tuesday after
+// when that is Easter Monday
+func otagoAnniversaryAnchor(yyyy int) []time.Time {
+	date := NearestMonday(time.Date(yyyy, time.March, 23, 0, 0, 0, 0, time.UTC), nil)
+	if date.Equal(calculateGregorianEasterMonday(yyyy)) {
+		date = date.AddDate(0, 0, 1)
+	}
+	return []time.Time{date}
+}
+
+// nzAnniversaryRules the provincial anniversary days by region
+var nzAnniversaryRules = map[string]HolidayRule{
+	"Auckland":        {Name: "Auckland Anniversary Day", Anchor: FixedDateAnchor(time.January, 29), Observance: NearestMonday},
+	"Wellington":      {Name: "Wellington Anniversary Day", Anchor: FixedDateAnchor(time.January, 22), Observance: NearestMonday},
+	"Nelson":          {Name: "Nelson Anniversary Day", Anchor: FixedDateAnchor(time.February, 1), Observance: NearestMonday},
+	"Taranaki":        {Name: "Taranaki Anniversary Day", Anchor: NthWeekdayAnchor(time.March, time.Monday, 2)},
+	"Otago":           {Name: "Otago Anniversary Day", Anchor: otagoAnniversaryAnchor},
+	"Southland":       {Name: "Southland Anniversary Day", Anchor: EasterAnchor(), Offset: 2},
+	"Hawke's Bay":     {Name: "Hawke's Bay Anniversary Day", Anchor: nzLabourDayAnchor, Offset: -3},
+	"Marlborough":     {Name: "Marlborough Anniversary Day", Anchor: nzLabourDayAnchor, Offset: 7},
+	"Canterbury":      {Name: "Canterbury Anniversary Day", Anchor: NthWeekdayAnchor(time.November, time.Tuesday, 1), Offset: 10},
+	"Chatham Islands": {Name: "Chatham Islands Anniversary Day", Anchor: FixedDateAnchor(time.November, 30), Observance: NearestMonday},
+	"Westland":        {Name: "Westland Anniversary Day", Anchor: FixedDateAnchor(time.December, 1), Observance: NearestMonday},
+}
+
+// nzHolidayRules the national public holidays of the Holidays Act 2003. New
+// Year, Christmas and Boxing Day on a weekend are Mondayised, and Waitangi
+// Day and ANZAC Day are from 2014.
+func nzHolidayRules() []HolidayRule {
+	weekend := SubstituteOn(time.Saturday, time.Sunday)
+	return []HolidayRule{
+		{Name: "New Year's Day", Anchor: FixedDateAnchor(time.January, 1), Substitute: weekend},
+		{Name: "Day after New Year's Day", Anchor: FixedDateAnchor(time.January, 2), Substitute: weekend},
+		{Name: "Waitangi Day", Anchor: FixedDateAnchor(time.February, 6), LastYear: 2013},
+		{Name: "Waitangi Day", Anchor: FixedDateAnchor(time.February, 6), FirstYear: 2014, Substitute: weekend},
+		{Name: "Good Friday", Anchor: EasterAnchor(), Offset: -2},
+		{Name: "Easter Monday", Anchor: EasterAnchor(), Offset: 1},
+		{Name: "ANZAC Day", Anchor: FixedDateAnchor(time.April, 25), LastYear: 2013},
+		{Name: "ANZAC Day", Anchor: FixedDateAnchor(time.April, 25), FirstYear: 2014, Substitute: weekend},
+		{Name: "Queen's Birthday", Anchor: NthWeekdayAnchor(time.June, time.Monday, 1), LastYear: 2022},
+		{Name: "King's Birthday", Anchor: NthWeekdayAnchor(time.June, time.Monday, 1), FirstYear: 2023},
+		{Name: "Matariki", Anchor: TableAnchor(matarikiDates...)},
+		{Name: "Labour Day", Anchor: nzLabourDayAnchor},
+		{Name: "Christmas Day", Anchor: FixedDateAnchor(time.December, 25), Substitute: weekend},
+		{Name: "Boxing Day", Anchor: FixedDateAnchor(time.December, 26), Substitute: weekend},
+	}
+}
+
+// NewZealandRegions returns the regions with an anniversary day
+func NewZealandRegions() []string {
+	regions := make([]string, 0, len(nzAnniversaryRules))
+	for region := range nzAnniversaryRules {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+// NewNewZealandCalendar returns the public holiday calendar of a New Zealand
+// region, the national holidays with its anniversary day, or the national
+// holidays alone when region is empty
+func NewNewZealandCalendar(region string) (*RuleCalendar, error) {
+	if region == "" {
+		return NewRuleCalendar("New Zealand", saturdaySundayWeekend, nzHolidayRules()), nil
+	}
+	rule, found := nzAnniversaryRules[region]
+	if !found {
+		return nil, fmt.Errorf("no anniversary day for region %q", region)
+	}
+	return NewRuleCalendar("New Zealand "+region, saturdaySundayWeekend, append(nzHolidayRules(), rule)), nil
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"time"
+)
+
+// The Nordic calendars are those of the banks, which also close on Christmas
+// Eve and New Year's Eve.
+
+// midsummerEveAnchor the Friday from June 19 to June 25
+var midsummerEveAnchor = WeekdayInWindowAnchor(time.Friday, time.June, 19, time.June, 25)
+
+// NewSwedenCalendar returns the bank holiday calendar of Sweden
+func NewSwedenCalendar() *RuleCalendar {
+	rules := []HolidayRule{
+		{Name: "Nyårsdagen", Anchor: FixedDateAnchor(time.January, 1)},
+		{Name: "Trettondedag jul", Anchor: FixedDateAnchor(time.January, 6)},
+		{Name: "Långfredagen", Anchor: EasterAnchor(), Offset: -2},
+		{Name: "Annandag påsk", Anchor: EasterAnchor(), Offset: 1},
+		{Name: "Första maj", Anchor: FixedDateAnchor(time.May, 1)},
+		{Name: "Kristi himmelsfärdsdag", Anchor: EasterAnchor(), Offset: 39},
+		{Name: "Annandag pingst", Anchor: EasterAnchor(), Offset: 50, LastYear: 2004},
+		{Name: "Sveriges nationaldag", Anchor: FixedDateAnchor(time.June, 6), FirstYear: 2005},
+		{Name: "Midsommarafton", Anchor: midsummerEveAnchor},
+		{Name: "Midsommardagen", Anchor: midsummerEveAnchor, Offset: 1},
+		{Name: "Alla helgons dag", Anchor: WeekdayInWindowAnchor(time.Saturday, time.October, 31, time.November, 6)},
+		{Name: "Julafton", Anchor: FixedDateAnchor(time.December, 24)},
+		{Name: "Juldagen", Anchor: FixedDateAnchor(time.December, 25)},
+		{Name: "Annandag jul", Anchor: FixedDateAnchor(time.December, 26)},
+		{Name: "Nyårsafton", Anchor: FixedDateAnchor(time.December, 31)},
+	}
+	return NewRuleCalendar("Sweden", saturdaySundayWeekend, rules)
+}
+
+// NewNorwayCalendar returns the bank holiday calendar of Norway
+func NewNorwayCalendar() *RuleCalendar {
+	rules := []HolidayRule{
+		{Name: "Første nyttårsdag", Anchor: FixedDateAnchor(time.January, 1)},
+		{Name: "Skjærtorsdag", Anchor: EasterAnchor(), Offset: -3},
+		{Name: "Langfredag", Anchor: EasterAnchor(), Offset: -2},
+		{Name: "Andre påskedag", Anchor: EasterAnchor(), Offset: 1},
+		{Name: "Arbeidernes dag", Anchor: FixedDateAnchor(time.May, 1)},
+		{Name: "Grunnlovsdag", Anchor: FixedDateAnchor(time.May, 17)},
+		{Name: "Kristi himmelfartsdag", Anchor: EasterAnchor(), Offset: 39},
+		{Name: "Andre pinsedag", Anchor: EasterAnchor(), Offset: 50},
+		{Name: "Julaften", Anchor: FixedDateAnchor(time.December, 24)},
+		{Name: "Første juledag", Anchor: FixedDateAnchor(time.December, 25)},
+		{Name: "Andre juledag", Anchor: FixedDateAnchor(time.December, 26)},
+		{Name: "Nyttårsaften", Anchor: FixedDateAnchor(time.December, 31)},
+	}
+	return NewRuleCalendar("Norway", saturdaySundayWeekend, rules)
+}
+
+// NewDenmarkCalendar returns the bank holiday calendar of Denmark. Great
+// Prayer Day was abolished from 2024; the banks also close on the day after
+// Ascension and on Constitution Day.
+func NewDenmarkCalendar() *RuleCalendar {
+	rules := []HolidayRule{
+		{Name: "Nytårsdag", Anchor: FixedDateAnchor(time.January, 1)},
+		{Name: "Skærtorsdag", Anchor: EasterAnchor(), Offset: -3},
+		{Name: "Langfredag", Anchor: EasterAnchor(), Offset: -2},
+		{Name: "2. påskedag", Anchor: EasterAnchor(), Offset: 1},
+		{Name: "Store bededag", Anchor: EasterAnchor(), Offset: 26, LastYear: 2023},
+		{Name: "Kristi himmelfartsdag", Anchor: EasterAnchor(), Offset: 39},
+		{Name: "Dagen efter Kristi himmelfartsdag", Anchor: EasterAnchor(), Offset: 40},
+		{Name: "2. pinsedag", Anchor: EasterAnchor(), Offset: 50},
+		{Name: "Grundlovsdag", Anchor: FixedDateAnchor(time.June, 5)},
+		{Name: "Juleaftensdag", Anchor: FixedDateAnchor(time.December, 24)},
+		{Name: "Juledag", Anchor: FixedDateAnchor(time.December, 25)},
+		{Name: "2. juledag", Anchor: FixedDateAnchor(time.December, 26)},
+		{Name: "Nytårsaftensdag", Anchor: FixedDateAnchor(time.December, 31)},
+	}
+	return NewRuleCalendar("Denmark", saturdaySundayWeekend, rules)
+}
+
+// NewFinlandCalendar returns the bank holiday calendar of Finland
+func NewFinlandCalendar() *RuleCalendar {
+	rules := []HolidayRule{
+		{Name: "Uudenvuodenpäivä", Anchor: FixedDateAnchor(time.January, 1)},
+		{Name: "Loppiainen", Anchor: FixedDateAnchor(time.January, 6)},
+		{Name: "Pitkäperjantai", Anchor: EasterAnchor(), Offset: -2},
+		{Name: "2. pääsiäispäivä", Anchor: EasterAnchor(), Offset: 1},
+		{Name: "Vappu", Anchor: FixedDateAnchor(time.May, 1)},
+		{Name: "Helatorstai", Anchor: EasterAnchor(), Offset: 39},
+		{Name: "Juhannusaatto", Anchor: midsummerEveAnchor},
+		{Name: "Juhannuspäivä", Anchor: midsummerEveAnchor, Offset: 1},
+		{Name: "Pyhäinpäivä", Anchor: WeekdayInWindowAnchor(time.Saturday, time.October, 31, time.November, 6)},
+		{Name: "Itsenäisyyspäivä", Anchor: FixedDateAnchor(time.December, 6)},
+		{Name: "Jouluaatto", Anchor: FixedDateAnchor(time.December, 24)},
+		{Name: "Joulupäivä", Anchor: FixedDateAnchor(time.December, 25)},
+		{Name: "Tapaninpäivä", Anchor: FixedDateAnchor(time.December, 26)},
+		{Name: "Uudenvuodenaatto", Anchor: FixedDateAnchor(time.December, 31)},
+	}
+	return NewRuleCalendar("Finland", saturdaySundayWeekend, rules)
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"time"
+)
+
+// ObservancePolicy returns the date on which a holiday falling on date is
+// observed, given the weekend of the calendar
+type ObservancePolicy func(date time.Time, weekend WeekendSchedule) time.Time
+
+// saturdaySundayWeekend the weekend of the legacy country structures
+var saturdaySundayWeekend = Weekend(time.Saturday, time.Sunday)
+
+// NextWorkday observes a weekend holiday on the first day after the weekend
+func NextWorkday(date time.Time, weekend WeekendSchedule) time.Time {
+	for weekend.IsWeekend(date) {
+		date = date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+// PreviousWorkday observes a weekend holiday on the last day before the weekend
+func PreviousWorkday(date time.Time, weekend WeekendSchedule) time.Time {
+	for weekend.IsWeekend(date) {
+		date = date.AddDate(0, 0, -1)
+	}
+	return date
+}
+
+// NearestWorkday observes a weekend holiday on the closest workday. With a
+// Saturday and Sunday weekend that is Friday for Saturday and Monday for
+// Sunday; ties go to the day after the weekend.
+func NearestWorkday(date time.Time, weekend WeekendSchedule) time.Time {
+	if !weekend.IsWeekend(date) {
+		return date
+	}
+	before := PreviousWorkday(date, weekend)
+	after := NextWorkday(date, weekend)
+	if date.Sub(before) < after.Sub(date) {
+		return before
+	}
+	return after
+}
+
+// SundayToMonday observes a holiday falling on a Sunday on the Monday after,
+// even when that Monday is a holiday already
+func SundayToMonday(date time.Time, weekend WeekendSchedule) time.Time {
+	if date.Weekday() == time.Sunday {
+		return date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+// NearestMonday observes a holiday on the Monday closest to it, whatever the
+// weekend: Tuesday to Thursday go back, Friday to Sunday forward
+func NearestMonday(date time.Time, weekend WeekendSchedule) time.Time {
+	days := (int(time.Monday) - int(date.Weekday()) + 7) % 7
+	if days > 3 {
+		days -= 7
+	}
+	return date.AddDate(0, 0, days)
+}
+
+// SubstitutionPolicy reports whether a holiday falling on date earns a
+// substitute day off. A holiday with a substitution policy also earns one
+// when it falls on the same day as another holiday.
+type SubstitutionPolicy func(date time.Time) bool
+
+// SubstituteOn earns a substitute day off for a holiday that falls on one of
+// the weekdays
+func SubstituteOn(days ...time.Weekday) SubstitutionPolicy {
+	return func(date time.Time) bool {
+		for _, day := range days {
+			if date.Weekday() == day {
+				return true
+			}
+		}
+		return false
+	}
+}
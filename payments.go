@@ -0,0 +1,183 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// JointCalendar the union of the holidays of several calendars, for example
+// the days a payment can settle in two currencies. A date is a business day
+// only when it is one in every calendar.
+type JointCalendar struct {
+	name      string
+	calendars []HolidayCalendar
+}
+
+// NewJointCalendar returns the joint calendar of calendars. An empty name
+// joins the names of the calendars.
+func NewJointCalendar(name string, calendars ...HolidayCalendar) *JointCalendar {
+	if name == "" {
+		names := make([]string, len(calendars))
+		for i, cal := range calendars {
+			names[i] = cal.Name()
+		}
+		name = strings.Join(names, "+")
+	}
+	return &JointCalendar{name: name, calendars: calendars}
+}
+
+// Name returns the name of the calendar
+func (cal *JointCalendar) Name() string {
+	return cal.name
+}
+
+// Holidays returns the holidays of all the calendars in the year yyyy in date
+// order. A holiday listed by several calendars is listed once; partial days
+// are left out.
+func (cal *JointCalendar) Holidays(yyyy int) []Holiday {
+	var holidays []Holiday
+	seen := make(map[Holiday]bool)
+	for _, c := range cal.calendars {
+		for _, holiday := range c.Holidays(yyyy) {
+			key := Holiday{Name: holiday.Name, Date: holiday.Date}
+			if holiday.Hours != nil || seen[key] {
+				continue
+			}
+			seen[key] = true
+			holidays = append(holidays, holiday)
+		}
+	}
+	sort.SliceStable(holidays, func(i, j int) bool {
+		return holidays[i].Date.Before(holidays[j].Date)
+	})
+	return holidays
+}
+
+// IsHoliday reports whether the date is a holiday in any of the calendars
+func (cal *JointCalendar) IsHoliday(date time.Time) bool {
+	for _, c := range cal.calendars {
+		if c.IsHoliday(date) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBusinessDay reports whether the date is a business day in all the
+// calendars
+func (cal *JointCalendar) IsBusinessDay(date time.Time) bool {
+	for _, c := range cal.calendars {
+		if !c.IsBusinessDay(date) {
+			return false
+		}
+	}
+	return true
+}
+
+// targetRules the closing days of TARGET, the euro settlement system, since
+// 2002
+func targetRules() []HolidayRule {
+	return []HolidayRule{
+		{Name: "New Year's Day", Anchor: FixedDateAnchor(time.January, 1)},
+		{Name: "Good Friday", Anchor: EasterAnchor(), Offset: -2},
+		{Name: "Easter Monday", Anchor: EasterAnchor(), Offset: 1},
+		{Name: "Labour Day", Anchor: FixedDateAnchor(time.May, 1)},
+		{Name: "Christmas Day", Anchor: FixedDateAnchor(time.December, 25)},
+		{Name: "Christmas Holiday", Anchor: FixedDateAnchor(time.December, 26)},
+	}
+}
+
+// NewTARGETCalendar returns the calendar of the TARGET system of the
+// Eurosystem, which is open on every weekday except its six closing days
+func NewTARGETCalendar() *RuleCalendar {
+	return NewRuleCalendar("TARGET", saturdaySundayWeekend, targetRules())
+}
+
+// NewSEPACalendar returns the calendar of SEPA credit transfers and direct
+// debits, which settle on the TARGET operating days
+func NewSEPACalendar() *RuleCalendar {
+	return NewRuleCalendar("SEPA", saturdaySundayWeekend, targetRules())
+}
+
+// NewCHAPSCalendar returns the calendar of CHAPS, the sterling settlement
+// system of the Bank of England, which is closed on the bank holidays of
+// England and Wales
+func NewCHAPSCalendar() *RuleCalendar {
+	return newEnglandRuleCalendar("CHAPS", englandBankHolidayRules())
+}
+
+// NewBoJNETCalendar returns the calendar of the BOJ-NET funds transfer
+// system of the Bank of Japan, which is closed on the bank holidays
+func NewBoJNETCalendar() *RuleCalendar {
+	return newJapanRuleCalendar("BOJ-NET", japanBankHolidayRules())
+}
+
+// NewSICCalendar returns the calendar of SIC, the Swiss franc settlement
+// system of the Swiss National Bank, which is closed on the bank holidays of
+// Zürich
+func NewSICCalendar() *RuleCalendar {
+	rules := append(swissFederalRules(), swissBerchtoldstag, swissGoodFriday, swissEasterMonday, swissLabourDay, swissWhitMonday, swissStStephen)
+	return NewRuleCalendar("SIC", saturdaySundayWeekend, rules)
+}
+
+// NewESASCalendar returns the calendar of ESAS, the New Zealand dollar
+// settlement system of the Reserve Bank of New Zealand, which is closed on
+// the national holidays and the Auckland and Wellington anniversary days
+func NewESASCalendar() *RuleCalendar {
+	rules := append(nzHolidayRules(), nzAnniversaryRules["Auckland"], nzAnniversaryRules["Wellington"])
+	return NewRuleCalendar("ESAS", saturdaySundayWeekend, rules)
+}
+
+// NewCLSCoreCalendar returns the days CLS does not settle in any currency
+func NewCLSCoreCalendar() *RuleCalendar {
+	rules := []HolidayRule{
+		{Name: "New Year's Day", Anchor: FixedDateAnchor(time.January, 1)},
+		{Name: "Christmas Day", Anchor: FixedDateAnchor(time.December, 25)},
+	}
+	return NewRuleCalendar("CLS", saturdaySundayWeekend, rules)
+}
+
+// clsCurrencyCalendars the settlement calendar of each CLS currency with a
+// calendar in this package
+var clsCurrencyCalendars = map[string]func() HolidayCalendar{
+	"USD": func() HolidayCalendar { return NewFedwireCalendar() },
+	"EUR": func() HolidayCalendar { return NewTARGETCalendar() },
+	"GBP": func() HolidayCalendar { return NewCHAPSCalendar() },
+	"JPY": func() HolidayCalendar { return NewBoJNETCalendar() },
+	"KRW": func() HolidayCalendar { return NewKoreaCalendar() },
+	"SEK": func() HolidayCalendar { return NewSwedenCalendar() },
+	"NOK": func() HolidayCalendar { return NewNorwayCalendar() },
+	"DKK": func() HolidayCalendar { return NewDenmarkCalendar() },
+	"ZAR": func() HolidayCalendar { return NewSouthAfricaCalendar() },
+	"CHF": func() HolidayCalendar { return NewSICCalendar() },
+	"NZD": func() HolidayCalendar { return NewESASCalendar() },
+	"CAD": func() HolidayCalendar { return NewCanadaCalendar() },
+	"AUD": func() HolidayCalendar { return NewAustraliaCalendar() },
+	"HKD": func() HolidayCalendar { return NewHongKongCalendar() },
+	"SGD": func() HolidayCalendar { return NewSingaporeCalendar(nil) },
+	"MXN": func() HolidayCalendar { return NewBMVCalendar() },
+	// CLS settles the shekel Monday to Friday, off the Israeli holidays
+	"ILS": func() HolidayCalendar { return NewRuleCalendar("ILS", saturdaySundayWeekend, israelHolidayRules()) },
+}
+
+// CLSCurrencies returns the CLS currencies with a calendar
+func CLSCurrencies() []string {
+	currencies := make([]string, 0, len(clsCurrencyCalendars))
+	for currency := range clsCurrencyCalendars {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+	return currencies
+}
+
+// NewCLSCalendar returns the days CLS settles a currency, by its ISO 4217
+// code: the days both CLS and the settlement system of the currency are open
+func NewCLSCalendar(currency string) (*JointCalendar, error) {
+	calendar, found := clsCurrencyCalendars[currency]
+	if !found {
+		return nil, fmt.Errorf("no CLS calendar for currency %q", currency)
+	}
+	return NewJointCalendar("CLS "+currency, NewCLSCoreCalendar(), calendar()), nil
+}
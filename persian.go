@@ -0,0 +1,144 @@
+package main
+
+import (
+	"time"
+
+	"github.com/soniakeys/meeus/julian"
+	"github.com/soniakeys/meeus/solstice"
+)
+
+// Solar Hijri months. The first six have 31 days, the next five 30 and Esfand
+// 29, or 30 in leap years.
+const (
+	Farvardin = 1 + iota
+	Ordibehesht
+	Khordad
+	Tir
+	Mordad
+	Shahrivar
+	Mehr
+	Aban
+	Azar
+	Dey
+	Bahman
+	Esfand
+)
+
+// iranStandardTime the zone of the 52.5 degree meridian, whose noon decides
+// the start of the Solar Hijri year
+var iranStandardTime = time.FixedZone("UTC+3:30", 3*60*60+30*60)
+
+// Tehran the observer for the Islamic holidays of Iran
+var Tehran = Observer{Name: "Tehran", Latitude: 35.6892, Longitude: 51.3890, Location: iranStandardTime}
+
+// SolarHijriDate a date in the Solar Hijri (Persian) calendar of Iran and
+// Afghanistan
+type SolarHijriDate struct {
+	Year  int `json:"Year" yaml:"Year" bson:"Year"`
+	Month int `json:"Month" yaml:"Month" bson:"Month"`
+	Day   int `json:"Day" yaml:"Day" bson:"Day"`
+}
+
+// NowruzDate returns the date of Nowruz, 1 Farvardin, in the Gregorian year
+// yyyy. The year starts on the day of the March equinox when the equinox is
+// before noon Iran Standard Time, and on the day after when it is not.
+func NowruzDate(yyyy int) time.Time {
+	equinox := julian.JDToTime(solstice.March(yyyy)).In(iranStandardTime)
+	date := localDate(equinox, iranStandardTime)
+	if equinox.Hour() >= 12 {
+		date = date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+// solarHijriMonthOffset returns the days from 1 Farvardin to the first of the month
+func solarHijriMonthOffset(month int) int {
+	if month <= Mehr {
+		return 31 * (month - 1)
+	}
+	return 6*31 + 30*(month-Mehr)
+}
+
+// SolarHijriToGregorian converts a Solar Hijri date to a Gregorian date
+func SolarHijriToGregorian(date SolarHijriDate) time.Time {
+	return NowruzDate(date.Year+621).AddDate(0, 0, solarHijriMonthOffset(date.Month)+date.Day-1)
+}
+
+// GregorianToSolarHijri converts a Gregorian date to a Solar Hijri date
+func GregorianToSolarHijri(date time.Time) SolarHijriDate {
+	year := date.Year() - 621
+	nowruz := NowruzDate(date.Year())
+	if dayNumber(date) < dayNumber(nowruz) {
+		year--
+		nowruz = NowruzDate(date.Year() - 1)
+	}
+	days := dayNumber(date) - dayNumber(nowruz)
+	month := Esfand
+	for solarHijriMonthOffset(month) > days {
+		month--
+	}
+	return SolarHijriDate{Year: year, Month: month, Day: days - solarHijriMonthOffset(month) + 1}
+}
+
+// NowruzAnchor anchors a holiday on Nowruz, the Persian new year at the
+// vernal equinox. Calendars that observe Nowruz use it with an Offset for the
+// days that follow, for example Sizdah Bedar on the 13th day.
+func NowruzAnchor() HolidayAnchor {
+	return func(yyyy int) []time.Time {
+		return []time.Time{NowruzDate(yyyy)}
+	}
+}
+
+// SolarHijriAnchor anchors a holiday on a Solar Hijri date
+func SolarHijriAnchor(month, day int) HolidayAnchor {
+	return func(yyyy int) []time.Time {
+		// Farvardin to the tenth of Dey fall in the Gregorian year of Nowruz
+		var dates []time.Time
+		for year := yyyy - 622; year <= yyyy-621; year++ {
+			if date := SolarHijriToGregorian(SolarHijriDate{Year: year, Month: month, Day: day}); date.Year() == yyyy {
+				dates = append(dates, date)
+			}
+		}
+		return dates
+	}
+}
+
+// NewIranCalendar returns the public holiday calendar of Iran. The weekend is
+// Friday. The Islamic holidays follow hijri, which defaults to a crescent
+// sighting estimate for Tehran.
+func NewIranCalendar(hijri HijriCalendar) *RuleCalendar {
+	if hijri == nil {
+		hijri = NewCrescentHijri(Tehran)
+	}
+	rules := []HolidayRule{
+		{Name: "Nowruz", Anchor: NowruzAnchor()},
+		{Name: "Nowruz", Anchor: NowruzAnchor(), Offset: 1},
+		{Name: "Nowruz", Anchor: NowruzAnchor(), Offset: 2},
+		{Name: "Nowruz", Anchor: NowruzAnchor(), Offset: 3},
+		{Name: "Islamic Republic Day", Anchor: NowruzAnchor(), Offset: 11},
+		{Name: "Sizdah Bedar", Anchor: NowruzAnchor(), Offset: 12},
+		{Name: "Death of Imam Khomeini", Anchor: SolarHijriAnchor(Khordad, 14)},
+		{Name: "15 Khordad Uprising", Anchor: SolarHijriAnchor(Khordad, 15)},
+		{Name: "Islamic Revolution Day", Anchor: SolarHijriAnchor(Bahman, 22)},
+		{Name: "Oil Nationalization Day", Anchor: SolarHijriAnchor(Esfand, 29)},
+		{Name: "Tasua", Anchor: HijriAnchor(hijri, Muharram, 9)},
+		{Name: "Ashura", Anchor: HijriAnchor(hijri, Muharram, 10)},
+		{Name: "Arbaeen", Anchor: HijriAnchor(hijri, Safar, 20)},
+		{Name: "Death of the Prophet", Anchor: HijriAnchor(hijri, Safar, 28)},
+		// the last day of Safar, the 29th or the 30th
+		{Name: "Martyrdom of Imam Reza", Anchor: HijriAnchor(hijri, RabiAlAwwal, 1), Offset: -1},
+		{Name: "Martyrdom of Imam Hasan al-Askari", Anchor: HijriAnchor(hijri, RabiAlAwwal, 8)},
+		{Name: "Birth of the Prophet", Anchor: HijriAnchor(hijri, RabiAlAwwal, 17)},
+		{Name: "Martyrdom of Fatimah", Anchor: HijriAnchor(hijri, JumadaAlAkhirah, 3)},
+		{Name: "Birth of Imam Ali", Anchor: HijriAnchor(hijri, Rajab, 13)},
+		{Name: "Mab'ath", Anchor: HijriAnchor(hijri, Rajab, 27)},
+		{Name: "Birth of Imam Mahdi", Anchor: HijriAnchor(hijri, Shaban, 15)},
+		{Name: "Martyrdom of Imam Ali", Anchor: HijriAnchor(hijri, Ramadan, 21)},
+		{Name: "Eid al-Fitr", Anchor: HijriAnchor(hijri, Shawwal, 1)},
+		{Name: "Eid al-Fitr", Anchor: HijriAnchor(hijri, Shawwal, 2)},
+		{Name: "Martyrdom of Imam Sadiq", Anchor: HijriAnchor(hijri, Shawwal, 25)},
+		{Name: "Eid al-Adha", Anchor: HijriAnchor(hijri, DhuAlHijjah, 10)},
+		{Name: "Eid al-Ghadir", Anchor: HijriAnchor(hijri, DhuAlHijjah, 18)},
+	}
+	return NewRuleCalendar("Iran", Weekend(time.Friday), rules)
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNowruzDate(t *testing.T) {
+	tests := []struct {
+		yyyy int
+		want time.Time
+	}{
+		// the March equinox at 00:54 Iran Standard Time on March 21
+		{2023, time.Date(2023, time.March, 21, 0, 0, 0, 0, time.UTC)},
+		// at 06:36 on March 20, before noon
+		{2024, time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC)},
+		// at 12:31 on March 20, after noon
+		{2025, time.Date(2025, time.March, 21, 0, 0, 0, 0, time.UTC)},
+		// at 18:16 on March 20
+		{2026, time.Date(2026, time.March, 21, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		if got := NowruzDate(test.yyyy); !got.Equal(test.want) {
+			t.Errorf("NowruzDate(%d) = %s, want %s", test.yyyy, got.Format("2006-01-02"), test.want.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestSolarHijriToGregorian(t *testing.T) {
+	tests := []struct {
+		date SolarHijriDate
+		want time.Time
+	}{
+		{SolarHijriDate{Year: 1402, Month: Farvardin, Day: 1}, time.Date(2023, time.March, 21, 0, 0, 0, 0, time.UTC)},
+		{SolarHijriDate{Year: 1402, Month: Bahman, Day: 22}, time.Date(2024, time.February, 11, 0, 0, 0, 0, time.UTC)},
+		{SolarHijriDate{Year: 1403, Month: Farvardin, Day: 1}, time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC)},
+		{SolarHijriDate{Year: 1403, Month: Khordad, Day: 14}, time.Date(2024, time.June, 3, 0, 0, 0, 0, time.UTC)},
+		{SolarHijriDate{Year: 1403, Month: Dey, Day: 12}, time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{SolarHijriDate{Year: 1403, Month: Esfand, Day: 30}, time.Date(2025, time.March, 20, 0, 0, 0, 0, time.UTC)},
+		{SolarHijriDate{Year: 1404, Month: Farvardin, Day: 1}, time.Date(2025, time.March, 21, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		got := SolarHijriToGregorian(test.date)
+		if !got.Equal(test.want) {
+			t.Errorf("SolarHijriToGregorian(%v) = %s, want %s", test.date, got.Format("2006-01-02"), test.want.Format("2006-01-02"))
+		}
+		if back := GregorianToSolarHijri(test.want); back != test.date {
+			t.Errorf("GregorianToSolarHijri(%s) = %v, want %v", test.want.Format("2006-01-02"), back, test.date)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"time"
+)
+
+// HolidayAnchor returns the dates in the Gregorian year yyyy on which a
+// holiday rule is anchored. Most anchors return exactly one date; anchors
+// tied to lunar calendars can return none or two dates in the same year.
+type HolidayAnchor func(yyyy int) []time.Time
+
+// FixedDateAnchor anchors a holiday on the same month and day every year
+func FixedDateAnchor(mm time.Month, dd int) HolidayAnchor {
+	return func(yyyy int) []time.Time {
+		return []time.Time{time.Date(yyyy, mm, dd, 0, 0, 0, 0, time.UTC)}
+	}
+}
+
+// NthWeekdayAnchor anchors a holiday on the nth weekday of the month, or the
+// nth from the end of the month when n is negative, for example the third
+// Monday of November or the last Monday of May
+func NthWeekdayAnchor(mm time.Month, weekday time.Weekday, n int) HolidayAnchor {
+	return func(yyyy int) []time.Time {
+		return []time.Time{returnNthWeekday(yyyy, mm, weekday, n)}
+	}
+}
+
+// WeekdayInWindowAnchor anchors a holiday on the weekday that falls between
+// two dates of the year, both included, for example Midsummer Eve on the
+// Friday from June 19 to June 25. A window longer than a week yields each of
+// its weekdays.
+func WeekdayInWindowAnchor(weekday time.Weekday, fromMonth time.Month, fromDay int, toMonth time.Month, toDay int) HolidayAnchor {
+	return func(yyyy int) []time.Time {
+		var dates []time.Time
+		last := time.Date(yyyy, toMonth, toDay, 0, 0, 0, 0, time.UTC)
+		for date := time.Date(yyyy, fromMonth, fromDay, 0, 0, 0, 0, time.UTC); !date.After(last); date = date.AddDate(0, 0, 1) {
+			if date.Weekday() == weekday {
+				dates = append(dates, date)
+			}
+		}
+		return dates
+	}
+}
+
+// weekdayAnchor keeps the dates of anchor that fall on one of the weekdays,
+// for the holidays and early closes that only apply on some days of the week
+func weekdayAnchor(anchor HolidayAnchor, weekdays ...time.Weekday) HolidayAnchor {
+	return func(yyyy int) []time.Time {
+		var dates []time.Time
+		for _, date := range anchor(yyyy) {
+			for _, weekday := range weekdays {
+				if date.Weekday() == weekday {
+					dates = append(dates, date)
+				}
+			}
+		}
+		return dates
+	}
+}
+
+// EasterAnchor anchors a holiday on Western Easter Sunday. Rules set Offset
+// for the moveable feasts, for example -2 for Good Friday, -47 for Carnival
+// Tuesday or 60 for Corpus Christi.
+func EasterAnchor() HolidayAnchor {
+	return func(yyyy int) []time.Time {
+		return []time.Time{calculateGregorianEaster(yyyy)}
+	}
+}
+
+// TableAnchor anchors a holiday on dates fixed in advance, such as those set
+// out in a schedule of an act. Years the table does not cover have no holiday.
+func TableAnchor(dates ...time.Time) HolidayAnchor {
+	return func(yyyy int) []time.Time {
+		var found []time.Time
+		for _, date := range dates {
+			if date.Year() == yyyy {
+				found = append(found, date)
+			}
+		}
+		return found
+	}
+}
+
+// AnnouncedAnchor anchors a holiday on the officially announced dates in the
+// years that have them, and on the dates of anchor in the other years
+func AnnouncedAnchor(anchor HolidayAnchor, announced ...time.Time) HolidayAnchor {
+	return func(yyyy int) []time.Time {
+		var dates []time.Time
+		for _, date := range announced {
+			if date.Year() == yyyy {
+				dates = append(dates, date)
+			}
+		}
+		if dates == nil {
+			return anchor(yyyy)
+		}
+		return dates
+	}
+}
+
+// localDate returns the calendar date of t in the location loc as a
+// midnight UTC date, which is how every holiday date is represented.
+func localDate(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
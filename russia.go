@@ -0,0 +1,130 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// HolidayTransfer a day off moved by government decree from the date From to
+// the date To. From is a weekend day, either one that fell on a holiday or a
+// Saturday that becomes a working day; To becomes a holiday.
+type HolidayTransfer struct {
+	From time.Time `json:"From" yaml:"From" bson:"From"`
+	To   time.Time `json:"To" yaml:"To" bson:"To"`
+}
+
+// russiaTransfers the days off transferred by the annual decrees of the
+// Government of the Russian Federation
+var russiaTransfers = []HolidayTransfer{
+	// decree 1314 of August 10 2023
+	{From: time.Date(2024, time.January, 6, 0, 0, 0, 0, time.UTC), To: time.Date(2024, time.May, 10, 0, 0, 0, 0, time.UTC)},
+	{From: time.Date(2024, time.January, 7, 0, 0, 0, 0, time.UTC), To: time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)},
+	{From: time.Date(2024, time.April, 27, 0, 0, 0, 0, time.UTC), To: time.Date(2024, time.April, 29, 0, 0, 0, 0, time.UTC)},
+	{From: time.Date(2024, time.November, 2, 0, 0, 0, 0, time.UTC), To: time.Date(2024, time.April, 30, 0, 0, 0, 0, time.UTC)},
+	{From: time.Date(2024, time.December, 28, 0, 0, 0, 0, time.UTC), To: time.Date(2024, time.December, 30, 0, 0, 0, 0, time.UTC)},
+	// decree 1335 of October 4 2024
+	{From: time.Date(2025, time.January, 4, 0, 0, 0, 0, time.UTC), To: time.Date(2025, time.May, 2, 0, 0, 0, 0, time.UTC)},
+	{From: time.Date(2025, time.January, 5, 0, 0, 0, 0, time.UTC), To: time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC)},
+	{From: time.Date(2025, time.February, 23, 0, 0, 0, 0, time.UTC), To: time.Date(2025, time.May, 8, 0, 0, 0, 0, time.UTC)},
+	{From: time.Date(2025, time.March, 8, 0, 0, 0, 0, time.UTC), To: time.Date(2025, time.June, 13, 0, 0, 0, 0, time.UTC)},
+	{From: time.Date(2025, time.November, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2025, time.November, 3, 0, 0, 0, 0, time.UTC)},
+	// decree of 2025 for 2026
+	{From: time.Date(2026, time.January, 3, 0, 0, 0, 0, time.UTC), To: time.Date(2026, time.January, 9, 0, 0, 0, 0, time.UTC)},
+	{From: time.Date(2026, time.January, 4, 0, 0, 0, 0, time.UTC), To: time.Date(2026, time.December, 31, 0, 0, 0, 0, time.UTC)},
+}
+
+// LoadHolidayTransfers reads the transfers of a decree from JSON:
+//
+//	[{"From": "2025-11-01", "To": "2025-11-03"}]
+func LoadHolidayTransfers(r io.Reader) ([]HolidayTransfer, error) {
+	var entries []struct {
+		From string
+		To   string
+	}
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, err
+	}
+	transfers := make([]HolidayTransfer, 0, len(entries))
+	for _, entry := range entries {
+		from, err := time.Parse("2006-01-02", entry.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := time.Parse("2006-01-02", entry.To)
+		if err != nil {
+			return nil, err
+		}
+		if from.Year() != to.Year() {
+			return nil, fmt.Errorf("transfer from %s to %s crosses the year", entry.From, entry.To)
+		}
+		transfers = append(transfers, HolidayTransfer{From: from, To: to})
+	}
+	return transfers, nil
+}
+
+// russiaHolidayRules the non-working holidays of article 112 of the Labour
+// Code. A holiday on a weekend day moves to the next working day, unless a
+// decree transfers the day off elsewhere; the weekend days of the New Year
+// holidays are always transferred by decree.
+func russiaHolidayRules(transferred map[int]bool) []HolidayRule {
+	substitute := func(date time.Time) bool {
+		return saturdaySundayWeekend.IsWeekend(date) && !transferred[dateKey(date)]
+	}
+	return []HolidayRule{
+		{Name: "Новогодние каникулы", Anchor: FixedDateAnchor(time.January, 1)},
+		{Name: "Новогодние каникулы", Anchor: FixedDateAnchor(time.January, 2)},
+		{Name: "Новогодние каникулы", Anchor: FixedDateAnchor(time.January, 3), FirstYear: 2005},
+		{Name: "Новогодние каникулы", Anchor: FixedDateAnchor(time.January, 4), FirstYear: 2005},
+		{Name: "Новогодние каникулы", Anchor: FixedDateAnchor(time.January, 5), FirstYear: 2005},
+		{Name: "Новогодние каникулы", Anchor: FixedDateAnchor(time.January, 6), FirstYear: 2013},
+		{Name: "Рождество Христово", Anchor: FixedDateAnchor(time.January, 7)},
+		{Name: "Новогодние каникулы", Anchor: FixedDateAnchor(time.January, 8), FirstYear: 2013},
+		{Name: "День защитника Отечества", Anchor: FixedDateAnchor(time.February, 23), Substitute: substitute},
+		{Name: "Международный женский день", Anchor: FixedDateAnchor(time.March, 8), Substitute: substitute},
+		{Name: "Праздник Весны и Труда", Anchor: FixedDateAnchor(time.May, 1), Substitute: substitute},
+		{Name: "Праздник Весны и Труда", Anchor: FixedDateAnchor(time.May, 2), LastYear: 2004},
+		{Name: "День Победы", Anchor: FixedDateAnchor(time.May, 9), Substitute: substitute},
+		{Name: "День России", Anchor: FixedDateAnchor(time.June, 12), Substitute: substitute},
+		{Name: "День народного единства", Anchor: FixedDateAnchor(time.November, 4), FirstYear: 2005, Substitute: substitute},
+		{Name: "Годовщина Октябрьской революции", Anchor: FixedDateAnchor(time.November, 7), LastYear: 2004},
+		{Name: "День Конституции", Anchor: FixedDateAnchor(time.December, 12), LastYear: 2004},
+	}
+}
+
+// NewRussiaCalendar returns the production calendar of Russia, the statutory
+// holidays with the days off transferred by decree. The decrees of 2024 to
+// 2026 are built in; transfers loaded with LoadHolidayTransfers replace them
+// for the years they cover. A transfer from a weekend day that is not a
+// holiday makes it a working day, and Saturdays made working days are business
+// days. The Moscow Exchange trades on the production calendar.
+func NewRussiaCalendar(transfers []HolidayTransfer) *RuleCalendar {
+	years := make(map[int]bool)
+	for _, transfer := range transfers {
+		years[transfer.From.Year()] = true
+	}
+	for _, transfer := range russiaTransfers {
+		if !years[transfer.From.Year()] {
+			transfers = append(transfers, transfer)
+		}
+	}
+	transferred := make(map[int]bool)
+	for _, transfer := range transfers {
+		transferred[dateKey(transfer.From)] = true
+	}
+	cal := NewRuleCalendar("Russia", saturdaySundayWeekend, russiaHolidayRules(transferred))
+	var working []time.Time
+	for _, transfer := range transfers {
+		if !cal.IsHoliday(transfer.From) {
+			working = append(working, transfer.From)
+		}
+	}
+	for _, transfer := range transfers {
+		cal.AddHoliday("Перенесённый выходной", transfer.To)
+	}
+	for _, date := range working {
+		cal.AddWorkingDay(date)
+	}
+	return cal
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+// Frequency the number of months in a regular period of a schedule
+type Frequency int
+
+// Frequencies of coupons and payments
+const (
+	Once       Frequency = 0 // one period from the start to the end
+	Monthly    Frequency = 1
+	Quarterly  Frequency = 3
+	SemiAnnual Frequency = 6
+	Annual     Frequency = 12
+)
+
+// StubType where a schedule puts the irregular period when the start and end
+// are not a whole number of periods apart, and whether it is shorter or
+// longer than a regular period
+type StubType int
+
+// Stub types. Front stubs generate the dates back from the end, back stubs
+// forward from the start.
+const (
+	ShortFront StubType = iota
+	LongFront
+	ShortBack
+	LongBack
+)
+
+// ScheduleSpec the terms of a schedule of coupon or payment dates
+type ScheduleSpec struct {
+	Start      time.Time
+	End        time.Time
+	Frequency  Frequency
+	Convention BusinessDayConvention // adjusts the dates to business days
+	Stub       StubType
+	EndOfMonth bool // keeps a month end start or end on the month ends
+	IMM        bool // rolls the regular dates on the third Wednesday of their month
+}
+
+// Schedule the dates of a schedule, from the start to the end, before and
+// after adjustment to the business days of a calendar
+type Schedule struct {
+	Unadjusted []time.Time
+	Adjusted   []time.Time
+}
+
+// GenerateSchedule returns the dates of the schedule of spec, adjusted to
+// the business days of the calendar cal. The start and end are kept as given;
+// the stub, if there is one, is the first or the last period.
+func GenerateSchedule(cal HolidayCalendar, spec ScheduleSpec) (Schedule, error) {
+	if !spec.End.After(spec.Start) {
+		return Schedule{}, fmt.Errorf("schedule end %s is not after its start %s", spec.End.Format("2006-01-02"), spec.Start.Format("2006-01-02"))
+	}
+	if spec.Frequency < Once {
+		return Schedule{}, fmt.Errorf("invalid schedule frequency %d", spec.Frequency)
+	}
+	dates := []time.Time{spec.Start, spec.End}
+	if spec.Frequency != Once {
+		if spec.Stub == ShortFront || spec.Stub == LongFront {
+			dates = backwardDates(spec)
+		} else {
+			dates = forwardDates(spec)
+		}
+	}
+	schedule := Schedule{Unadjusted: dates, Adjusted: make([]time.Time, len(dates))}
+	for i, date := range dates {
+		schedule.Adjusted[i] = Adjust(cal, date, spec.Convention)
+	}
+	return schedule, nil
+}
+
+// rollDate returns the regular date n periods from the anchor date
+func rollDate(anchor time.Time, n int, spec ScheduleSpec) time.Time {
+	date := AddMonths(anchor, n*int(spec.Frequency), spec.EndOfMonth)
+	if spec.IMM {
+		date = IMMDate(date.Year(), date.Month())
+	}
+	return date
+}
+
+// backwardDates generates the dates back from the end, with a front stub
+func backwardDates(spec ScheduleSpec) []time.Time {
+	dates := []time.Time{spec.End}
+	for n := 1; ; n++ {
+		date := rollDate(spec.End, -n, spec)
+		if !date.After(spec.Start) {
+			break
+		}
+		dates = append(dates, date)
+	}
+	// a long stub merges the short one into the first regular period
+	if spec.Stub == LongFront && len(dates) > 1 && !rollDate(spec.End, -len(dates), spec).Equal(spec.Start) {
+		dates = dates[:len(dates)-1]
+	}
+	dates = append(dates, spec.Start)
+	for i, j := 0, len(dates)-1; i < j; i, j = i+1, j-1 {
+		dates[i], dates[j] = dates[j], dates[i]
+	}
+	return dates
+}
+
+// forwardDates generates the dates forward from the start, with a back stub
+func forwardDates(spec ScheduleSpec) []time.Time {
+	dates := []time.Time{spec.Start}
+	for n := 1; ; n++ {
+		date := rollDate(spec.Start, n, spec)
+		if !date.Before(spec.End) {
+			break
+		}
+		dates = append(dates, date)
+	}
+	// a long stub merges the short one into the last regular period
+	if spec.Stub == LongBack && len(dates) > 1 && !rollDate(spec.Start, len(dates), spec).Equal(spec.End) {
+		dates = dates[:len(dates)-1]
+	}
+	return append(dates, spec.End)
+}
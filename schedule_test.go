@@ -0,0 +1,86 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGenerateSchedule(t *testing.T) {
+	date := func(yyyy int, mm time.Month, dd int) time.Time {
+		return time.Date(yyyy, mm, dd, 0, 0, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name string
+		spec ScheduleSpec
+		want []time.Time
+	}{
+		{"regular", ScheduleSpec{Start: date(2025, time.January, 15), End: date(2026, time.January, 15), Frequency: Quarterly, Stub: LongFront},
+			[]time.Time{date(2025, time.January, 15), date(2025, time.April, 15), date(2025, time.July, 15), date(2025, time.October, 15), date(2026, time.January, 15)}},
+		{"short front", ScheduleSpec{Start: date(2025, time.February, 10), End: date(2026, time.January, 15), Frequency: Quarterly, Stub: ShortFront},
+			[]time.Time{date(2025, time.February, 10), date(2025, time.April, 15), date(2025, time.July, 15), date(2025, time.October, 15), date(2026, time.January, 15)}},
+		{"long front", ScheduleSpec{Start: date(2025, time.February, 10), End: date(2026, time.January, 15), Frequency: Quarterly, Stub: LongFront},
+			[]time.Time{date(2025, time.February, 10), date(2025, time.July, 15), date(2025, time.October, 15), date(2026, time.January, 15)}},
+		{"short back", ScheduleSpec{Start: date(2025, time.January, 15), End: date(2025, time.December, 10), Frequency: Quarterly, Stub: ShortBack},
+			[]time.Time{date(2025, time.January, 15), date(2025, time.April, 15), date(2025, time.July, 15), date(2025, time.October, 15), date(2025, time.December, 10)}},
+		{"long back", ScheduleSpec{Start: date(2025, time.January, 15), End: date(2025, time.December, 10), Frequency: Quarterly, Stub: LongBack},
+			[]time.Time{date(2025, time.January, 15), date(2025, time.April, 15), date(2025, time.July, 15), date(2025, time.December, 10)}},
+		{"end of month", ScheduleSpec{Start: date(2025, time.February, 28), End: date(2025, time.August, 31), Frequency: Quarterly, Stub: ShortBack, EndOfMonth: true},
+			[]time.Time{date(2025, time.February, 28), date(2025, time.May, 31), date(2025, time.August, 31)}},
+		{"not end of month", ScheduleSpec{Start: date(2025, time.February, 28), End: date(2025, time.August, 31), Frequency: Quarterly, Stub: ShortBack},
+			[]time.Time{date(2025, time.February, 28), date(2025, time.May, 28), date(2025, time.August, 28), date(2025, time.August, 31)}},
+		// the third Wednesdays of the quarter months
+		{"IMM", ScheduleSpec{Start: date(2025, time.March, 19), End: date(2026, time.March, 18), Frequency: Quarterly, Stub: ShortFront, IMM: true},
+			[]time.Time{date(2025, time.March, 19), date(2025, time.June, 18), date(2025, time.September, 17), date(2025, time.December, 17), date(2026, time.March, 18)}},
+		{"once", ScheduleSpec{Start: date(2025, time.March, 19), End: date(2026, time.March, 18), Frequency: Once},
+			[]time.Time{date(2025, time.March, 19), date(2026, time.March, 18)}},
+	}
+	for _, test := range tests {
+		schedule, err := GenerateSchedule(NewTARGETCalendar(), test.spec)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if len(schedule.Unadjusted) != len(test.want) {
+			t.Errorf("%s: got %d dates, want %d: %v", test.name, len(schedule.Unadjusted), len(test.want), schedule.Unadjusted)
+			continue
+		}
+		for i, want := range test.want {
+			if got := schedule.Unadjusted[i]; !got.Equal(want) {
+				t.Errorf("%s: date %d = %s, want %s", test.name, i, got.Format("2006-01-02"), want.Format("2006-01-02"))
+			}
+		}
+	}
+}
+
+func TestGenerateScheduleAdjusted(t *testing.T) {
+	date := func(yyyy int, mm time.Month, dd int) time.Time {
+		return time.Date(yyyy, mm, dd, 0, 0, 0, 0, time.UTC)
+	}
+	spec := ScheduleSpec{Start: date(2025, time.January, 31), End: date(2025, time.June, 30), Frequency: Monthly, Convention: ModifiedFollowing, Stub: ShortBack, EndOfMonth: true}
+	schedule, err := GenerateSchedule(NewTARGETCalendar(), spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Saturday May 31 stays in May
+	want := []time.Time{date(2025, time.January, 31), date(2025, time.February, 28), date(2025, time.March, 31), date(2025, time.April, 30), date(2025, time.May, 30), date(2025, time.June, 30)}
+	if len(schedule.Adjusted) != len(want) {
+		t.Fatalf("got %d dates, want %d: %v", len(schedule.Adjusted), len(want), schedule.Adjusted)
+	}
+	for i := range want {
+		if !schedule.Adjusted[i].Equal(want[i]) {
+			t.Errorf("adjusted date %d = %s, want %s", i, schedule.Adjusted[i].Format("2006-01-02"), want[i].Format("2006-01-02"))
+		}
+	}
+}
+
+func TestGenerateScheduleInvalid(t *testing.T) {
+	start := time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC)
+	for _, spec := range []ScheduleSpec{
+		{Start: start, End: start, Frequency: Quarterly},
+		{Start: start, End: start.AddDate(1, 0, 0), Frequency: -1},
+	} {
+		if _, err := GenerateSchedule(NewTARGETCalendar(), spec); err == nil {
+			t.Errorf("GenerateSchedule(%v) returned no error", spec)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"time"
+)
+
+// SessionHours the trading hours of a partial day, as the time after local
+// midnight. A zero Opens or Closes keeps the usual opening or closing time,
+// so a late open sets only Opens and an early close only Closes.
+type SessionHours struct {
+	Opens  time.Duration `json:"Opens,omitempty" yaml:"Opens,omitempty" bson:"Opens,omitempty"`
+	Closes time.Duration `json:"Closes,omitempty" yaml:"Closes,omitempty" bson:"Closes,omitempty"`
+}
+
+// Session returns the trading hours of the date when it is a partial day of
+// the calendar, and false when it is not
+func (cal *RuleCalendar) Session(date time.Time) (SessionHours, bool) {
+	hours, found := cal.year(date.Year()).sessions[dateKey(date)]
+	return hours, found
+}
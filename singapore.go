@@ -0,0 +1,98 @@
+package main
+
+import (
+	"time"
+)
+
+// Singapore the observer for the Islamic holidays of Singapore
+var Singapore = Observer{Name: "Singapore", Latitude: 1.3521, Longitude: 103.8198, Location: chinaStandardTime}
+
+// singaporeHijriMonthStarts the starts of Shawwal and Dhu al-Hijjah announced
+// by the Islamic Religious Council of Singapore, which fix Hari Raya Puasa
+// and Hari Raya Haji
+var singaporeHijriMonthStarts = []HijriMonthStart{
+	{Year: 1440, Month: Shawwal, Start: time.Date(2019, time.June, 5, 0, 0, 0, 0, time.UTC)},
+	{Year: 1440, Month: DhuAlHijjah, Start: time.Date(2019, time.August, 2, 0, 0, 0, 0, time.UTC)},
+	{Year: 1441, Month: Shawwal, Start: time.Date(2020, time.May, 24, 0, 0, 0, 0, time.UTC)},
+	{Year: 1441, Month: DhuAlHijjah, Start: time.Date(2020, time.July, 22, 0, 0, 0, 0, time.UTC)},
+	{Year: 1442, Month: Shawwal, Start: time.Date(2021, time.May, 13, 0, 0, 0, 0, time.UTC)},
+	{Year: 1442, Month: DhuAlHijjah, Start: time.Date(2021, time.July, 11, 0, 0, 0, 0, time.UTC)},
+	{Year: 1443, Month: Shawwal, Start: time.Date(2022, time.May, 3, 0, 0, 0, 0, time.UTC)},
+	{Year: 1443, Month: DhuAlHijjah, Start: time.Date(2022, time.July, 1, 0, 0, 0, 0, time.UTC)},
+	{Year: 1444, Month: Shawwal, Start: time.Date(2023, time.April, 22, 0, 0, 0, 0, time.UTC)},
+	{Year: 1444, Month: DhuAlHijjah, Start: time.Date(2023, time.June, 20, 0, 0, 0, 0, time.UTC)},
+	{Year: 1445, Month: Shawwal, Start: time.Date(2024, time.April, 10, 0, 0, 0, 0, time.UTC)},
+	{Year: 1445, Month: DhuAlHijjah, Start: time.Date(2024, time.June, 8, 0, 0, 0, 0, time.UTC)},
+	{Year: 1446, Month: Shawwal, Start: time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC)},
+	{Year: 1446, Month: DhuAlHijjah, Start: time.Date(2025, time.May, 29, 0, 0, 0, 0, time.UTC)},
+	{Year: 1447, Month: Shawwal, Start: time.Date(2026, time.March, 21, 0, 0, 0, 0, time.UTC)},
+	{Year: 1447, Month: DhuAlHijjah, Start: time.Date(2026, time.May, 18, 0, 0, 0, 0, time.UTC)},
+}
+
+// singaporeVesakDays and singaporeDeepavaliDays the dates gazetted by the
+// Ministry of Manpower, which follow the Buddhist and Tamil calendars
+var (
+	singaporeVesakDays = []time.Time{
+		time.Date(2019, time.May, 19, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, time.May, 7, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.May, 26, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, time.May, 15, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, time.June, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.May, 22, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.May, 12, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, time.May, 31, 0, 0, 0, 0, time.UTC),
+	}
+	singaporeDeepavaliDays = []time.Time{
+		time.Date(2019, time.October, 27, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, time.November, 14, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.November, 4, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, time.October, 24, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, time.November, 12, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.October, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.October, 20, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, time.November, 8, 0, 0, 0, 0, time.UTC),
+	}
+)
+
+// singaporeAddedHolidays the polling days and the holidays declared for a
+// single year
+var singaporeAddedHolidays = []Holiday{
+	{Name: "SG50 Public Holiday", Date: time.Date(2015, time.August, 7, 0, 0, 0, 0, time.UTC)},
+	{Name: "Polling Day", Date: time.Date(2015, time.September, 11, 0, 0, 0, 0, time.UTC)},
+	{Name: "Polling Day", Date: time.Date(2020, time.July, 10, 0, 0, 0, 0, time.UTC)},
+	{Name: "Polling Day", Date: time.Date(2023, time.September, 1, 0, 0, 0, 0, time.UTC)},
+	{Name: "Polling Day", Date: time.Date(2025, time.May, 3, 0, 0, 0, 0, time.UTC)},
+	{Name: "SG60 Public Holiday", Date: time.Date(2025, time.August, 18, 0, 0, 0, 0, time.UTC)},
+}
+
+// NewSingaporeCalendar returns the public holiday calendar of Singapore, on
+// which Singapore dollar payments settle. A holiday on a Sunday gives the
+// next day that is not a holiday off. Vesak Day and Deepavali take the
+// gazetted dates and are otherwise computed from the lunar and Hindu
+// calendars. The Islamic holidays follow hijri, which defaults to the
+// announced month starts on top of a crescent sighting estimate for
+// Singapore.
+func NewSingaporeCalendar(hijri HijriCalendar) *RuleCalendar {
+	if hijri == nil {
+		hijri = NewHijriTable(NewCrescentHijri(Singapore), singaporeHijriMonthStarts...)
+	}
+	sunday := SubstituteOn(time.Sunday)
+	rules := []HolidayRule{
+		{Name: "New Year's Day", Anchor: FixedDateAnchor(time.January, 1), Substitute: sunday},
+		{Name: "Chinese New Year", Anchor: LunarAnchor(1, 1, chinaStandardTime), Substitute: sunday},
+		{Name: "Chinese New Year", Anchor: LunarAnchor(1, 2, chinaStandardTime), Substitute: sunday},
+		{Name: "Good Friday", Anchor: EasterAnchor(), Offset: -2},
+		{Name: "Hari Raya Puasa", Anchor: HijriAnchor(hijri, Shawwal, 1), Substitute: sunday},
+		{Name: "Labour Day", Anchor: FixedDateAnchor(time.May, 1), Substitute: sunday},
+		{Name: "Vesak Day", Anchor: AnnouncedAnchor(LunarAnchor(4, 15, chinaStandardTime), singaporeVesakDays...), Substitute: sunday},
+		{Name: "Hari Raya Haji", Anchor: HijriAnchor(hijri, DhuAlHijjah, 10), Substitute: sunday},
+		{Name: "National Day", Anchor: FixedDateAnchor(time.August, 9), Substitute: sunday},
+		{Name: "Deepavali", Anchor: AnnouncedAnchor(diwaliAnchor, singaporeDeepavaliDays...), Substitute: sunday},
+		{Name: "Christmas Day", Anchor: FixedDateAnchor(time.December, 25), Substitute: sunday},
+	}
+	cal := NewRuleCalendar("Singapore", saturdaySundayWeekend, rules)
+	for _, holiday := range singaporeAddedHolidays {
+		cal.AddHoliday(holiday.Name, holiday.Date)
+	}
+	return cal
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"math"
+	"time"
+
+	"github.com/soniakeys/meeus/julian"
+	"github.com/soniakeys/meeus/solstice"
+)
+
+// SolarTerm one of the 24 solar terms (jieqi) of the East Asian calendars.
+// Each term starts when the apparent longitude of the Sun reaches a multiple
+// of 15 degrees. The constants are in the order they occur in a Gregorian year.
+type SolarTerm int
+
+// Solar terms in Gregorian calendar order, starting with Minor Cold in January
+const (
+	MinorCold          SolarTerm = iota // Xiaohan 285°
+	MajorCold                           // Dahan 300°
+	StartOfSpring                       // Lichun 315°
+	RainWater                           // Yushui 330°
+	AwakeningOfInsects                  // Jingzhe 345°
+	SpringEquinox                       // Chunfen 0°
+	Qingming                            // Qingming (Ching Ming), Pure Brightness 15°
+	GrainRain                           // Guyu 30°
+	StartOfSummer                       // Lixia 45°
+	GrainBuds                           // Xiaoman 60°
+	GrainInEar                          // Mangzhong 75°
+	SummerSolstice                      // Xiazhi 90°
+	MinorHeat                           // Xiaoshu 105°
+	MajorHeat                           // Dashu 120°
+	StartOfAutumn                       // Liqiu 135°
+	EndOfHeat                           // Chushu 150°
+	WhiteDew                            // Bailu 165°
+	AutumnEquinox                       // Qiufen 180°
+	ColdDew                             // Hanlu 195°
+	FrostsDescent                       // Shuangjiang 210°
+	StartOfWinter                       // Lidong 225°
+	MinorSnow                           // Xiaoxue 240°
+	MajorSnow                           // Daxue 255°
+	WinterSolstice                      // Dongzhi 270°
+)
+
+var solarTermNames = [...]string{
+	"Xiaohan", "Dahan", "Lichun", "Yushui", "Jingzhe", "Chunfen",
+	"Qingming", "Guyu", "Lixia", "Xiaoman", "Mangzhong", "Xiazhi",
+	"Xiaoshu", "Dashu", "Liqiu", "Chushu", "Bailu", "Qiufen",
+	"Hanlu", "Shuangjiang", "Lidong", "Xiaoxue", "Daxue", "Dongzhi",
+}
+
+// chinaStandardTime is the zone the Chinese calendar uses for solar terms
+var chinaStandardTime = time.FixedZone("UTC+8", 8*60*60)
+
+// String returns the pinyin name of the solar term
+func (term SolarTerm) String() string {
+	if term < MinorCold || term > WinterSolstice {
+		return "SolarTerm(?)"
+	}
+	return solarTermNames[term]
+}
+
+// Longitude returns the apparent solar longitude in degrees at which the term starts
+func (term SolarTerm) Longitude() float64 {
+	return math.Mod(285+15*float64(term), 360)
+}
+
+// apparentSolarLongitude returns the apparent geocentric longitude of the Sun
+// in degrees for the julian ephemeris day jde. This is the low accuracy
+// method of Meeus chapter 25, good to about 0.01 degree.
+func apparentSolarLongitude(jde float64) float64 {
+	t := (jde - 2451545) / 36525
+	l0 := 280.46646 + 36000.76983*t + 0.0003032*t*t
+	m := degreesToRadians(357.52911 + 35999.05029*t - 0.0001537*t*t)
+	c := (1.914602-0.004817*t-0.000014*t*t)*math.Sin(m) +
+		(0.019993-0.000101*t)*math.Sin(2*m) +
+		0.000289*math.Sin(3*m)
+	omega := degreesToRadians(125.04 - 1934.136*t)
+	return normalizeDegrees(l0 + c - 0.00569 - 0.00478*math.Sin(omega))
+}
+
+// SolarLongitudeEvent returns the instant (UTC) in the Gregorian year yyyy at
+// which the apparent longitude of the Sun reaches the given longitude in degrees.
+func SolarLongitudeEvent(yyyy int, longitude float64) time.Time {
+	longitude = normalizeDegrees(longitude)
+	// start from the March equinox and step forward the mean motion of the Sun
+	event := solarLongitudeNear(solstice.March(yyyy)+longitude/360*365.2422, longitude)
+	// longitudes past about 280 degrees are reached early in the year,
+	// before the equinox, so the estimate may land in the next year
+	switch {
+	case event.Year() > yyyy:
+		event = solarLongitudeNear(julian.TimeToJD(event)-365.2422, longitude)
+	case event.Year() < yyyy:
+		event = solarLongitudeNear(julian.TimeToJD(event)+365.2422, longitude)
+	}
+	return event
+}
+
+// solarLongitudeNear refines the estimate jde of the instant the Sun reaches
+// the given longitude, Meeus chapter 27
+func solarLongitudeNear(jde float64, longitude float64) time.Time {
+	for i := 0; i < 20; i++ {
+		correction := 58 * math.Sin(degreesToRadians(longitude-apparentSolarLongitude(jde)))
+		jde += correction
+		if math.Abs(correction) < 1e-6 {
+			break
+		}
+	}
+	return julian.JDToTime(jde)
+}
+
+// SolarTermDate returns the date in the location loc on which the solar term
+// starts in the Gregorian year yyyy
+func SolarTermDate(yyyy int, term SolarTerm, loc *time.Location) time.Time {
+	return localDate(SolarLongitudeEvent(yyyy, term.Longitude()), loc)
+}
+
+// SolarTermDates returns the local dates of all 24 solar terms in the year yyyy
+func SolarTermDates(yyyy int, loc *time.Location) [24]time.Time {
+	var dates [24]time.Time
+	for term := MinorCold; term <= WinterSolstice; term++ {
+		dates[term] = SolarTermDate(yyyy, term, loc)
+	}
+	return dates
+}
+
+// SolarTermAnchor anchors a holiday on the local date of a solar term, for
+// example Qingming in China, Hong Kong and Taiwan, or the equinox days in Japan.
+func SolarTermAnchor(term SolarTerm, loc *time.Location) HolidayAnchor {
+	return func(yyyy int) []time.Time {
+		return []time.Time{SolarTermDate(yyyy, term, loc)}
+	}
+}
+
+// degreesToRadians converts an angle in degrees to radians
+func degreesToRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+// normalizeDegrees reduces an angle in degrees to the range [0, 360)
+func normalizeDegrees(degrees float64) float64 {
+	degrees = math.Mod(degrees, 360)
+	if degrees < 0 {
+		degrees += 360
+	}
+	return degrees
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSolarTermDate(t *testing.T) {
+	jst := time.FixedZone("UTC+9", 9*60*60)
+	tests := []struct {
+		yyyy int
+		term SolarTerm
+		loc  *time.Location
+		want time.Time
+	}{
+		// Qingming, Hong Kong Observatory
+		{2019, Qingming, chinaStandardTime, time.Date(2019, time.April, 5, 0, 0, 0, 0, time.UTC)},
+		{2020, Qingming, chinaStandardTime, time.Date(2020, time.April, 4, 0, 0, 0, 0, time.UTC)},
+		{2022, Qingming, chinaStandardTime, time.Date(2022, time.April, 5, 0, 0, 0, 0, time.UTC)},
+		{2024, Qingming, chinaStandardTime, time.Date(2024, time.April, 4, 0, 0, 0, 0, time.UTC)},
+		{2026, Qingming, chinaStandardTime, time.Date(2026, time.April, 5, 0, 0, 0, 0, time.UTC)},
+		{2025, StartOfSpring, chinaStandardTime, time.Date(2025, time.February, 3, 0, 0, 0, 0, time.UTC)},
+		{2024, StartOfSpring, chinaStandardTime, time.Date(2024, time.February, 4, 0, 0, 0, 0, time.UTC)},
+		{2023, WinterSolstice, chinaStandardTime, time.Date(2023, time.December, 22, 0, 0, 0, 0, time.UTC)},
+		{2024, WinterSolstice, chinaStandardTime, time.Date(2024, time.December, 21, 0, 0, 0, 0, time.UTC)},
+		// the equinox days of the Japanese calendar
+		{2023, SpringEquinox, jst, time.Date(2023, time.March, 21, 0, 0, 0, 0, time.UTC)},
+		{2024, SpringEquinox, jst, time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC)},
+		{2024, AutumnEquinox, jst, time.Date(2024, time.September, 22, 0, 0, 0, 0, time.UTC)},
+		{2025, AutumnEquinox, jst, time.Date(2025, time.September, 23, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		if got := SolarTermDate(test.yyyy, test.term, test.loc); !got.Equal(test.want) {
+			t.Errorf("SolarTermDate(%d, %s) = %s, want %s", test.yyyy, test.term, got.Format("2006-01-02"), test.want.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestSolarTermLongitude(t *testing.T) {
+	if got := SpringEquinox.Longitude(); got != 0 {
+		t.Errorf("SpringEquinox.Longitude() = %v, want 0", got)
+	}
+	if got := WinterSolstice.Longitude(); got != 270 {
+		t.Errorf("WinterSolstice.Longitude() = %v, want 270", got)
+	}
+}
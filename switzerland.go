@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// afternoonOff the hours of a holiday that starts at noon
+var afternoonOff = &SessionHours{Closes: 12 * time.Hour}
+
+// sechselautenAnchor the third Monday of April, or the Monday after when that
+// is Easter Monday
+func sechselautenAnchor(yyyy int) []time.Time {
+	date := returnNthWeekday(yyyy, time.April, time.Monday, 3)
+	if date.Equal(calculateGregorianEasterMonday(yyyy)) {
+		date = date.AddDate(0, 0, 7)
+	}
+	return []time.Time{date}
+}
+
+// nafelserFahrtAnchor the first Thursday of April, or the Thursday after when
+// that is Maundy Thursday
+func nafelserFahrtAnchor(yyyy int) []time.Time {
+	date := returnNthWeekday(yyyy, time.April, time.Thursday, 1)
+	if date.Equal(calculateGregorianEaster(yyyy).AddDate(0, 0, -3)) {
+		date = date.AddDate(0, 0, 7)
+	}
+	return []time.Time{date}
+}
+
+// Holidays of the Swiss cantons
+var (
+	swissBerchtoldstag   = HolidayRule{Name: "Berchtoldstag", Anchor: FixedDateAnchor(time.January, 2)}
+	swissEpiphany        = HolidayRule{Name: "Epiphany", Anchor: FixedDateAnchor(time.January, 6)}
+	swissSaintJoseph     = HolidayRule{Name: "Saint Joseph", Anchor: FixedDateAnchor(time.March, 19)}
+	swissGoodFriday      = HolidayRule{Name: "Good Friday", Anchor: EasterAnchor(), Offset: -2}
+	swissEasterMonday    = HolidayRule{Name: "Easter Monday", Anchor: EasterAnchor(), Offset: 1}
+	swissLabourDay       = HolidayRule{Name: "Labour Day", Anchor: FixedDateAnchor(time.May, 1)}
+	swissWhitMonday      = HolidayRule{Name: "Whit Monday", Anchor: EasterAnchor(), Offset: 50}
+	swissCorpusChristi   = HolidayRule{Name: "Corpus Christi", Anchor: EasterAnchor(), Offset: 60}
+	swissSaintsPeterPaul = HolidayRule{Name: "Saints Peter and Paul", Anchor: FixedDateAnchor(time.June, 29)}
+	swissAssumption      = HolidayRule{Name: "Assumption", Anchor: FixedDateAnchor(time.August, 15)}
+	swissAllSaints       = HolidayRule{Name: "All Saints' Day", Anchor: FixedDateAnchor(time.November, 1)}
+	swissImmaculate      = HolidayRule{Name: "Immaculate Conception", Anchor: FixedDateAnchor(time.December, 8)}
+	swissStStephen       = HolidayRule{Name: "St. Stephen's Day", Anchor: FixedDateAnchor(time.December, 26)}
+)
+
+// swissCantonRules the holidays each canton adds to the federal ones
+var swissCantonRules = map[string][]HolidayRule{
+	"ZH": {swissBerchtoldstag, swissGoodFriday, swissEasterMonday, swissLabourDay, swissWhitMonday, swissStStephen,
+		{Name: "Sechseläuten", Anchor: sechselautenAnchor, Hours: afternoonOff},
+		// the Monday after the second Sunday of September
+		{Name: "Knabenschiessen", Anchor: NthWeekdayAnchor(time.September, time.Sunday, 2), Offset: 1, Hours: afternoonOff},
+	},
+	"BE": {swissBerchtoldstag, swissGoodFriday, swissEasterMonday, swissWhitMonday, swissStStephen},
+	"LU": {swissBerchtoldstag, swissGoodFriday, swissEasterMonday, swissWhitMonday, swissCorpusChristi, swissAssumption, swissAllSaints, swissImmaculate, swissStStephen},
+	"UR": {swissEpiphany, swissSaintJoseph, swissGoodFriday, swissEasterMonday, swissWhitMonday, swissCorpusChristi, swissAssumption, swissAllSaints, swissImmaculate, swissStStephen},
+	"SZ": {swissEpiphany, swissSaintJoseph, swissGoodFriday, swissEasterMonday, swissWhitMonday, swissCorpusChristi, swissAssumption, swissAllSaints, swissImmaculate, swissStStephen},
+	"OW": {swissBerchtoldstag, swissGoodFriday, swissEasterMonday, swissWhitMonday, swissCorpusChristi, swissAssumption,
+		{Name: "Bruder Klaus", Anchor: FixedDateAnchor(time.September, 25)},
+		swissAllSaints, swissImmaculate, swissStStephen,
+	},
+	"NW": {swissSaintJoseph, swissGoodFriday, swissEasterMonday, swissWhitMonday, swissCorpusChristi, swissAssumption, swissAllSaints, swissImmaculate, swissStStephen},
+	"GL": {swissBerchtoldstag,
+		{Name: "Näfelser Fahrt", Anchor: nafelserFahrtAnchor},
+		swissGoodFriday, swissEasterMonday, swissWhitMonday, swissAllSaints, swissStStephen,
+	},
+	"ZG": {swissBerchtoldstag, swissGoodFriday, swissEasterMonday, swissWhitMonday, swissCorpusChristi, swissAssumption, swissAllSaints, swissImmaculate, swissStStephen},
+	"FR": {swissBerchtoldstag, swissGoodFriday, swissEasterMonday, swissWhitMonday, swissCorpusChristi, swissAssumption, swissAllSaints, swissImmaculate, swissStStephen},
+	"SO": {swissBerchtoldstag, swissGoodFriday, swissEasterMonday, swissWhitMonday, swissCorpusChristi, swissAssumption, swissAllSaints, swissStStephen,
+		{Name: "Labour Day", Anchor: FixedDateAnchor(time.May, 1), Hours: afternoonOff},
+	},
+	"BS": {swissGoodFriday, swissEasterMonday, swissLabourDay, swissWhitMonday, swissStStephen},
+	"BL": {swissGoodFriday, swissEasterMonday, swissLabourDay, swissWhitMonday, swissStStephen},
+	"SH": {swissBerchtoldstag, swissGoodFriday, swissEasterMonday, swissLabourDay, swissWhitMonday, swissStStephen},
+	"AR": {swissGoodFriday, swissEasterMonday, swissWhitMonday, swissStStephen},
+	"AI": {swissGoodFriday, swissEasterMonday, swissWhitMonday, swissCorpusChristi, swissAssumption, swissAllSaints, swissImmaculate, swissStStephen},
+	"SG": {swissGoodFriday, swissEasterMonday, swissWhitMonday, swissAllSaints, swissStStephen},
+	"GR": {swissGoodFriday, swissEasterMonday, swissWhitMonday, swissStStephen},
+	"AG": {swissBerchtoldstag, swissGoodFriday, swissEasterMonday, swissWhitMonday, swissStStephen},
+	"TG": {swissBerchtoldstag, swissGoodFriday, swissEasterMonday, swissLabourDay, swissWhitMonday, swissStStephen},
+	"TI": {swissEpiphany, swissSaintJoseph, swissEasterMonday, swissLabourDay, swissWhitMonday, swissCorpusChristi, swissSaintsPeterPaul, swissAssumption, swissAllSaints, swissImmaculate, swissStStephen},
+	"VD": {swissBerchtoldstag, swissGoodFriday, swissEasterMonday, swissWhitMonday, swissStStephen,
+		// the Monday after the third Sunday of September, the federal fast
+		{Name: "Lundi du Jeûne", Anchor: NthWeekdayAnchor(time.September, time.Sunday, 3), Offset: 1},
+	},
+	"VS": {swissSaintJoseph, swissCorpusChristi, swissAssumption, swissAllSaints, swissImmaculate},
+	"NE": {
+		// the days after New Year's Day and Christmas Day only when these
+		// fall on a Sunday
+		{Name: "Berchtoldstag", Anchor: weekdayAnchor(FixedDateAnchor(time.January, 2), time.Monday)},
+		{Name: "Instauration de la République", Anchor: FixedDateAnchor(time.March, 1)},
+		swissGoodFriday, swissEasterMonday, swissLabourDay, swissWhitMonday,
+		{Name: "St. Stephen's Day", Anchor: weekdayAnchor(FixedDateAnchor(time.December, 26), time.Monday)},
+	},
+	"GE": {swissGoodFriday, swissEasterMonday, swissWhitMonday,
+		// the Thursday after the first Sunday of September
+		{Name: "Jeûne genevois", Anchor: NthWeekdayAnchor(time.September, time.Sunday, 1), Offset: 4},
+		{Name: "Restauration de la République", Anchor: FixedDateAnchor(time.December, 31)},
+	},
+	"JU": {swissBerchtoldstag, swissGoodFriday, swissEasterMonday, swissLabourDay, swissWhitMonday, swissCorpusChristi,
+		{Name: "Commémoration du plébiscite jurassien", Anchor: FixedDateAnchor(time.June, 23)},
+		swissAssumption, swissAllSaints, swissStStephen,
+	},
+}
+
+// swissFederalRules the holidays kept in all cantons: the national day and
+// the days the federal law treats as Sundays
+func swissFederalRules() []HolidayRule {
+	return []HolidayRule{
+		{Name: "New Year's Day", Anchor: FixedDateAnchor(time.January, 1)},
+		{Name: "Ascension Day", Anchor: EasterAnchor(), Offset: 39},
+		{Name: "Swiss National Day", Anchor: FixedDateAnchor(time.August, 1)},
+		{Name: "Christmas Day", Anchor: FixedDateAnchor(time.December, 25)},
+	}
+}
+
+// SwissCantons returns the codes of the cantons with a holiday calendar
+func SwissCantons() []string {
+	cantons := make([]string, 0, len(swissCantonRules))
+	for canton := range swissCantonRules {
+		cantons = append(cantons, canton)
+	}
+	sort.Strings(cantons)
+	return cantons
+}
+
+// NewSwitzerlandCalendar returns the holiday calendar of a Swiss canton, by
+// its two letter code, or the federal holidays alone when canton is empty.
+// The afternoon holidays of Zürich and Solothurn are partial days.
+func NewSwitzerlandCalendar(canton string) (*RuleCalendar, error) {
+	if canton == "" {
+		return NewRuleCalendar("Switzerland", saturdaySundayWeekend, swissFederalRules()), nil
+	}
+	rules, found := swissCantonRules[canton]
+	if !found {
+		return nil, fmt.Errorf("no holiday calendar for canton %q", canton)
+	}
+	return NewRuleCalendar("Switzerland "+canton, saturdaySundayWeekend, append(swissFederalRules(), rules...)), nil
+}
+
+// NewSIXCalendar returns the trading calendar of the SIX Swiss Exchange in
+// Zürich, which trades on the Zürich afternoon holidays
+func NewSIXCalendar() *RuleCalendar {
+	rules := append(swissFederalRules(), swissBerchtoldstag, swissGoodFriday, swissEasterMonday, swissLabourDay, swissWhitMonday,
+		HolidayRule{Name: "Christmas Eve", Anchor: FixedDateAnchor(time.December, 24)},
+		swissStStephen,
+		HolidayRule{Name: "New Year's Eve", Anchor: FixedDateAnchor(time.December, 31)},
+	)
+	return NewRuleCalendar("SIX", saturdaySundayWeekend, rules)
+}
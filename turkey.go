@@ -0,0 +1,38 @@
+package main
+
+import (
+	"time"
+)
+
+// turkeyHalfDay the hours of the afternoons off before the bayrams
+var turkeyHalfDay = &SessionHours{Closes: 13 * time.Hour}
+
+// NewTurkeyCalendar returns the public holiday calendar of Turkey. The
+// afternoons before Republic Day and the two bayrams are partial days. The
+// bayrams follow hijri, which defaults to Umm al-Qura; pass a HijriTable to
+// apply the dates announced by the Presidency of Religious Affairs.
+func NewTurkeyCalendar(hijri HijriCalendar) *RuleCalendar {
+	if hijri == nil {
+		hijri = NewUmmAlQuraHijri()
+	}
+	rules := []HolidayRule{
+		{Name: "Yılbaşı", Anchor: FixedDateAnchor(time.January, 1)},
+		{Name: "Ulusal Egemenlik ve Çocuk Bayramı", Anchor: FixedDateAnchor(time.April, 23)},
+		{Name: "Emek ve Dayanışma Günü", Anchor: FixedDateAnchor(time.May, 1), FirstYear: 2009},
+		{Name: "Atatürk'ü Anma, Gençlik ve Spor Bayramı", Anchor: FixedDateAnchor(time.May, 19)},
+		{Name: "Demokrasi ve Millî Birlik Günü", Anchor: FixedDateAnchor(time.July, 15), FirstYear: 2017},
+		{Name: "Zafer Bayramı", Anchor: FixedDateAnchor(time.August, 30)},
+		{Name: "Cumhuriyet Bayramı Arifesi", Anchor: FixedDateAnchor(time.October, 28), Hours: turkeyHalfDay},
+		{Name: "Cumhuriyet Bayramı", Anchor: FixedDateAnchor(time.October, 29)},
+		{Name: "Ramazan Bayramı Arifesi", Anchor: HijriAnchor(hijri, Shawwal, 1), Offset: -1, Hours: turkeyHalfDay},
+		{Name: "Ramazan Bayramı", Anchor: HijriAnchor(hijri, Shawwal, 1)},
+		{Name: "Ramazan Bayramı", Anchor: HijriAnchor(hijri, Shawwal, 2)},
+		{Name: "Ramazan Bayramı", Anchor: HijriAnchor(hijri, Shawwal, 3)},
+		{Name: "Kurban Bayramı Arifesi", Anchor: HijriAnchor(hijri, DhuAlHijjah, 9), Hours: turkeyHalfDay},
+		{Name: "Kurban Bayramı", Anchor: HijriAnchor(hijri, DhuAlHijjah, 10)},
+		{Name: "Kurban Bayramı", Anchor: HijriAnchor(hijri, DhuAlHijjah, 11)},
+		{Name: "Kurban Bayramı", Anchor: HijriAnchor(hijri, DhuAlHijjah, 12)},
+		{Name: "Kurban Bayramı", Anchor: HijriAnchor(hijri, DhuAlHijjah, 13)},
+	}
+	return NewRuleCalendar("Turkey", saturdaySundayWeekend, rules)
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"time"
+)
+
+// englandAddedHolidays the bank holidays declared by royal proclamation
+var englandAddedHolidays = []Holiday{
+	{Name: "Millennium Celebrations", Date: time.Date(1999, time.December, 31, 0, 0, 0, 0, time.UTC)},
+	{Name: "Royal Wedding", Date: time.Date(2011, time.April, 29, 0, 0, 0, 0, time.UTC)},
+	{Name: "Diamond Jubilee", Date: time.Date(2012, time.June, 5, 0, 0, 0, 0, time.UTC)},
+	{Name: "Platinum Jubilee", Date: time.Date(2022, time.June, 3, 0, 0, 0, 0, time.UTC)},
+	{Name: "State Funeral of Queen Elizabeth II", Date: time.Date(2022, time.September, 19, 0, 0, 0, 0, time.UTC)},
+	{Name: "Coronation of King Charles III", Date: time.Date(2023, time.May, 8, 0, 0, 0, 0, time.UTC)},
+}
+
+// englandBankHolidayRules the bank holidays of England and Wales. A holiday
+// on a weekend gives the next weekday off. The early May and spring bank
+// holidays moved for anniversaries in 1995, 2002, 2012, 2020 and 2022.
+func englandBankHolidayRules() []HolidayRule {
+	weekend := SubstituteOn(time.Saturday, time.Sunday)
+	return []HolidayRule{
+		{Name: "New Year's Day", Anchor: FixedDateAnchor(time.January, 1), Substitute: weekend},
+		{Name: "Good Friday", Anchor: EasterAnchor(), Offset: -2},
+		{Name: "Easter Monday", Anchor: EasterAnchor(), Offset: 1},
+		{Name: "Early May Bank Holiday", Anchor: AnnouncedAnchor(NthWeekdayAnchor(time.May, time.Monday, 1),
+			time.Date(1995, time.May, 8, 0, 0, 0, 0, time.UTC),
+			time.Date(2020, time.May, 8, 0, 0, 0, 0, time.UTC))},
+		{Name: "Spring Bank Holiday", Anchor: AnnouncedAnchor(NthWeekdayAnchor(time.May, time.Monday, -1),
+			time.Date(2002, time.June, 4, 0, 0, 0, 0, time.UTC),
+			time.Date(2012, time.June, 4, 0, 0, 0, 0, time.UTC),
+			time.Date(2022, time.June, 2, 0, 0, 0, 0, time.UTC))},
+		{Name: "Summer Bank Holiday", Anchor: NthWeekdayAnchor(time.August, time.Monday, -1)},
+		{Name: "Christmas Day", Anchor: FixedDateAnchor(time.December, 25), Substitute: weekend},
+		{Name: "Boxing Day", Anchor: FixedDateAnchor(time.December, 26), Substitute: weekend},
+	}
+}
+
+// newEnglandRuleCalendar returns a calendar of the England and Wales rules
+// and the bank holidays declared by proclamation
+func newEnglandRuleCalendar(name string, rules []HolidayRule) *RuleCalendar {
+	cal := NewRuleCalendar(name, saturdaySundayWeekend, rules)
+	for _, holiday := range englandAddedHolidays {
+		cal.AddHoliday(holiday.Name, holiday.Date)
+	}
+	return cal
+}
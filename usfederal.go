@@ -0,0 +1,81 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// inaugurationDayAnchor January 20 of the year after a presidential
+// election, or January 21 when the 20th is a Sunday
+func inaugurationDayAnchor(yyyy int) []time.Time {
+	if yyyy%4 != 1 {
+		return nil
+	}
+	date := time.Date(yyyy, time.January, 20, 0, 0, 0, 0, time.UTC)
+	if date.Weekday() == time.Sunday {
+		date = date.AddDate(0, 0, 1)
+	}
+	return []time.Time{date}
+}
+
+// usExecutiveOrderClosures the days federal offices were closed by executive
+// order or proclamation of the President
+var usExecutiveOrderClosures = []Holiday{
+	{Name: "National Day of Mourning for President Richard Nixon", Date: time.Date(1994, time.April, 27, 0, 0, 0, 0, time.UTC)},
+	{Name: "Christmas Eve", Date: time.Date(2001, time.December, 24, 0, 0, 0, 0, time.UTC)},
+	{Name: "Day after Christmas", Date: time.Date(2003, time.December, 26, 0, 0, 0, 0, time.UTC)},
+	{Name: "National Day of Mourning for President Ronald Reagan", Date: time.Date(2004, time.June, 11, 0, 0, 0, 0, time.UTC)},
+	{Name: "National Day of Mourning for President Gerald R. Ford", Date: time.Date(2007, time.January, 2, 0, 0, 0, 0, time.UTC)},
+	{Name: "Christmas Eve", Date: time.Date(2007, time.December, 24, 0, 0, 0, 0, time.UTC)},
+	{Name: "Day after Christmas", Date: time.Date(2008, time.December, 26, 0, 0, 0, 0, time.UTC)},
+	{Name: "Christmas Eve", Date: time.Date(2012, time.December, 24, 0, 0, 0, 0, time.UTC)},
+	{Name: "Day after Christmas", Date: time.Date(2014, time.December, 26, 0, 0, 0, 0, time.UTC)},
+	{Name: "Christmas Eve", Date: time.Date(2015, time.December, 24, 0, 0, 0, 0, time.UTC)},
+	{Name: "National Day of Mourning for President George H. W. Bush", Date: time.Date(2018, time.December, 5, 0, 0, 0, 0, time.UTC)},
+	{Name: "Christmas Eve", Date: time.Date(2018, time.December, 24, 0, 0, 0, 0, time.UTC)},
+	{Name: "Christmas Eve", Date: time.Date(2019, time.December, 24, 0, 0, 0, 0, time.UTC)},
+	{Name: "Christmas Eve", Date: time.Date(2020, time.December, 24, 0, 0, 0, 0, time.UTC)},
+	{Name: "Christmas Eve", Date: time.Date(2024, time.December, 24, 0, 0, 0, 0, time.UTC)},
+	{Name: "National Day of Mourning for President Jimmy Carter", Date: time.Date(2025, time.January, 9, 0, 0, 0, 0, time.UTC)},
+	{Name: "Christmas Eve", Date: time.Date(2025, time.December, 24, 0, 0, 0, 0, time.UTC)},
+	{Name: "Day after Christmas", Date: time.Date(2025, time.December, 26, 0, 0, 0, 0, time.UTC)},
+}
+
+// LoadExecutiveOrderClosures reads closures ordered after the built-in table
+// from JSON:
+//
+//	[{"Name": "Christmas Eve", "Date": "2026-12-24"}]
+func LoadExecutiveOrderClosures(r io.Reader) ([]Holiday, error) {
+	var entries []struct {
+		Name string
+		Date string
+	}
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return nil, err
+	}
+	closures := make([]Holiday, 0, len(entries))
+	for _, entry := range entries {
+		date, err := time.Parse("2006-01-02", entry.Date)
+		if err != nil {
+			return nil, err
+		}
+		closures = append(closures, Holiday{Name: entry.Name, Date: date})
+	}
+	return closures, nil
+}
+
+// NewUSFederalDCCalendar returns the holiday calendar of the federal
+// employees in the Washington DC area, the federal holidays with Inauguration
+// Day and the closures ordered by the President. The built-in closures are
+// kept alongside those passed in.
+func NewUSFederalDCCalendar(closures []Holiday) *RuleCalendar {
+	rules := append(usFederalRules(NearestWorkday),
+		HolidayRule{Name: "Inauguration Day", Anchor: inaugurationDayAnchor, FirstYear: 1965},
+	)
+	cal := NewRuleCalendar("US Federal (DC area)", saturdaySundayWeekend, rules)
+	for _, closure := range append(usExecutiveOrderClosures, closures...) {
+		cal.AddHoliday(closure.Name, closure.Date)
+	}
+	return cal
+}
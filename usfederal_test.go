@@ -0,0 +1,65 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUSFederalDCCalendar(t *testing.T) {
+	cal := NewUSFederalDCCalendar(nil)
+	tests := []struct {
+		date time.Time
+		want string
+	}{
+		// January 20 2013 was a Sunday
+		{time.Date(2013, time.January, 21, 0, 0, 0, 0, time.UTC), "Inauguration Day"},
+		{time.Date(2017, time.January, 20, 0, 0, 0, 0, time.UTC), "Inauguration Day"},
+		{time.Date(1994, time.April, 27, 0, 0, 0, 0, time.UTC), "National Day of Mourning for President Richard Nixon"},
+		{time.Date(2007, time.January, 2, 0, 0, 0, 0, time.UTC), "National Day of Mourning for President Gerald R. Ford"},
+		{time.Date(2008, time.December, 26, 0, 0, 0, 0, time.UTC), "Day after Christmas"},
+	}
+	for _, test := range tests {
+		var found bool
+		for _, holiday := range cal.Holidays(test.date.Year()) {
+			if holiday.Date.Equal(test.date) && holiday.Name == test.want {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("no %s on %s", test.want, test.date.Format("2006-01-02"))
+		}
+	}
+	for _, date := range []time.Time{
+		time.Date(2013, time.January, 20, 0, 0, 0, 0, time.UTC),
+		time.Date(2014, time.January, 20, 0, 0, 0, 0, time.UTC),
+	} {
+		for _, holiday := range cal.Holidays(date.Year()) {
+			if holiday.Name == "Inauguration Day" && holiday.Date.Equal(date) {
+				t.Errorf("Inauguration Day on %s", date.Format("2006-01-02"))
+			}
+		}
+	}
+}
+
+func TestLoadExecutiveOrderClosures(t *testing.T) {
+	closures, err := LoadExecutiveOrderClosures(strings.NewReader(`[{"Name": "Christmas Eve", "Date": "2026-12-24"}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Holiday{Name: "Christmas Eve", Date: time.Date(2026, time.December, 24, 0, 0, 0, 0, time.UTC)}
+	if len(closures) != 1 || closures[0].Name != want.Name || !closures[0].Date.Equal(want.Date) {
+		t.Errorf("LoadExecutiveOrderClosures = %v, want [%v]", closures, want)
+	}
+	if !NewUSFederalDCCalendar(closures).IsHoliday(want.Date) {
+		t.Errorf("loaded closure on %s is not a holiday", want.Date.Format("2006-01-02"))
+	}
+	for _, data := range []string{
+		`{"Name": "Christmas Eve", "Date": "2026-12-24"}`,
+		`[{"Name": "Christmas Eve", "Date": "12/24/2026"}]`,
+	} {
+		if _, err := LoadExecutiveOrderClosures(strings.NewReader(data)); err == nil {
+			t.Errorf("LoadExecutiveOrderClosures(%s) returned no error", data)
+		}
+	}
+}
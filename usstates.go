@@ -0,0 +1,316 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Anchors of the US holidays
+var (
+	thanksgivingAnchor = NthWeekdayAnchor(time.November, time.Thursday, 4)
+	// the Tuesday after the first Monday of November
+	usElectionDayAnchor = NthWeekdayAnchor(time.November, time.Monday, 1)
+	// the Tuesday after the first Monday of May, for the primaries of Indiana
+	usPrimaryDayAnchor = NthWeekdayAnchor(time.May, time.Monday, 1)
+)
+
+// evenYearAnchor keeps the dates of anchor in even years, for the general
+// elections some states close for
+func evenYearAnchor(anchor HolidayAnchor) HolidayAnchor {
+	return func(yyyy int) []time.Time {
+		if yyyy%2 != 0 {
+			return nil
+		}
+		return anchor(yyyy)
+	}
+}
+
+// usFederalRules the legal public holidays of 5 U.S.C. 6103, with the fixed
+// date holidays moved off the weekend by observance
+func usFederalRules(observance ObservancePolicy) []HolidayRule {
+	return []HolidayRule{
+		{Name: "New Year's Day", Anchor: FixedDateAnchor(time.January, 1), Observance: observance},
+		{Name: "Birthday of Martin Luther King, Jr.", Anchor: NthWeekdayAnchor(time.January, time.Monday, 3), FirstYear: 1986},
+		{Name: "Washington's Birthday", Anchor: NthWeekdayAnchor(time.February, time.Monday, 3)},
+		{Name: "Memorial Day", Anchor: NthWeekdayAnchor(time.May, time.Monday, -1)},
+		{Name: "Juneteenth National Independence Day", Anchor: FixedDateAnchor(time.June, 19), FirstYear: 2021, Observance: observance},
+		{Name: "Independence Day", Anchor: FixedDateAnchor(time.July, 4), Observance: observance},
+		{Name: "Labor Day", Anchor: NthWeekdayAnchor(time.September, time.Monday, 1)},
+		{Name: "Columbus Day", Anchor: NthWeekdayAnchor(time.October, time.Monday, 2)},
+		{Name: "Veterans Day", Anchor: FixedDateAnchor(time.November, 11), Observance: observance},
+		{Name: "Thanksgiving Day", Anchor: thanksgivingAnchor},
+		{Name: "Christmas Day", Anchor: FixedDateAnchor(time.December, 25), Observance: observance},
+	}
+}
+
+// NewUSFederalCalendar returns the US federal holiday calendar. A holiday on
+// a Saturday is observed on the Friday before and one on a Sunday on the
+// Monday after.
+func NewUSFederalCalendar() *RuleCalendar {
+	return NewRuleCalendar("US Federal", saturdaySundayWeekend, usFederalRules(NearestWorkday))
+}
+
+// usState the holidays of a state government: the federal holidays it keeps,
+// with their weekend observance, and its own
+type usState struct {
+	name       string
+	observance ObservancePolicy // for the fixed date federal holidays
+	omit       []string         // federal holidays the state does not keep
+	rules      []HolidayRule
+}
+
+// Holidays kept by several states
+var (
+	dayAfterThanksgiving = HolidayRule{Name: "Day after Thanksgiving", Anchor: thanksgivingAnchor, Offset: 1}
+	christmasEve         = HolidayRule{Name: "Christmas Eve", Anchor: FixedDateAnchor(time.December, 24)}
+	dayAfterChristmas    = HolidayRule{Name: "Day after Christmas", Anchor: FixedDateAnchor(time.December, 26)}
+	newYearsEve          = HolidayRule{Name: "New Year's Eve", Anchor: FixedDateAnchor(time.December, 31)}
+	usGoodFriday         = HolidayRule{Name: "Good Friday", Anchor: EasterAnchor(), Offset: -2}
+	lincolnsBirthday     = HolidayRule{Name: "Lincoln's Birthday", Anchor: FixedDateAnchor(time.February, 12), Observance: NearestWorkday}
+	patriotsDay          = HolidayRule{Name: "Patriots' Day", Anchor: NthWeekdayAnchor(time.April, time.Monday, 3)}
+	generalElectionDay   = HolidayRule{Name: "Election Day", Anchor: evenYearAnchor(usElectionDayAnchor), Offset: 1}
+	primaryElectionDay   = HolidayRule{Name: "Primary Election Day", Anchor: evenYearAnchor(usPrimaryDayAnchor), Offset: 1}
+	electionDay          = HolidayRule{Name: "Election Day", Anchor: usElectionDayAnchor, Offset: 1}
+)
+
+// washingtonsBirthdayInDecember Washington's Birthday as Georgia and Indiana
+// keep it, on December 24 next to Christmas. It moves to the Thursday when
+// Christmas is observed on Friday December 24, to Friday December 23 when it
+// falls on a Saturday, and to Tuesday December 26 when it falls on a Sunday.
+var washingtonsBirthdayInDecember = HolidayRule{Name: "Washington's Birthday", Anchor: FixedDateAnchor(time.December, 24), Observance: func(date time.Time, weekend WeekendSchedule) time.Time {
+	switch date.Weekday() {
+	case time.Friday, time.Saturday:
+		return date.AddDate(0, 0, -1)
+	case time.Sunday:
+		return date.AddDate(0, 0, 2)
+	}
+	return date
+}}
+
+// indigenousPeoplesDay the holiday that replaces Columbus Day from a year
+func indigenousPeoplesDay(name string, firstYear int) []HolidayRule {
+	return []HolidayRule{
+		{Name: "Columbus Day", Anchor: NthWeekdayAnchor(time.October, time.Monday, 2), LastYear: firstYear - 1},
+		{Name: name, Anchor: NthWeekdayAnchor(time.October, time.Monday, 2), FirstYear: firstYear},
+	}
+}
+
+// juneteenth the state holiday of June 19 from a year
+func juneteenth(firstYear int) HolidayRule {
+	return HolidayRule{Name: "Juneteenth", Anchor: FixedDateAnchor(time.June, 19), FirstYear: firstYear, Observance: NearestWorkday}
+}
+
+// usStates the state government holiday calendars by postal code. Unless
+// listed, a state does not keep Juneteenth, which is taken out of the federal
+// holidays of every state.
+var usStates = map[string]usState{
+	"AL": {name: "Alabama", observance: NearestWorkday, rules: []HolidayRule{
+		{Name: "Confederate Memorial Day", Anchor: NthWeekdayAnchor(time.April, time.Monday, 4)},
+		{Name: "Jefferson Davis' Birthday", Anchor: NthWeekdayAnchor(time.June, time.Monday, 1)},
+	}},
+	"AK": {name: "Alaska", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: []HolidayRule{
+		{Name: "Seward's Day", Anchor: NthWeekdayAnchor(time.March, time.Monday, -1)},
+		{Name: "Alaska Day", Anchor: FixedDateAnchor(time.October, 18), Observance: NearestWorkday},
+	}},
+	"AZ": {name: "Arizona", observance: NearestWorkday},
+	"AR": {name: "Arkansas", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: []HolidayRule{
+		christmasEve,
+	}},
+	"CA": {name: "California", observance: SundayToMonday, omit: []string{"Columbus Day"}, rules: []HolidayRule{
+		{Name: "Lincoln's Birthday", Anchor: FixedDateAnchor(time.February, 12), LastYear: 2008, Observance: SundayToMonday},
+		{Name: "Cesar Chavez Day", Anchor: FixedDateAnchor(time.March, 31), FirstYear: 2001, Observance: SundayToMonday},
+		{Name: "Columbus Day", Anchor: NthWeekdayAnchor(time.October, time.Monday, 2), LastYear: 2008},
+		dayAfterThanksgiving,
+	}},
+	"CO": {name: "Colorado", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: []HolidayRule{
+		{Name: "Columbus Day", Anchor: NthWeekdayAnchor(time.October, time.Monday, 2), LastYear: 2019},
+		{Name: "Frances Xavier Cabrini Day", Anchor: NthWeekdayAnchor(time.October, time.Monday, 1), FirstYear: 2020},
+	}},
+	"CT": {name: "Connecticut", observance: NearestWorkday, rules: []HolidayRule{
+		lincolnsBirthday, usGoodFriday, juneteenth(2023),
+	}},
+	"DE": {name: "Delaware", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: []HolidayRule{
+		usGoodFriday, generalElectionDay, dayAfterThanksgiving,
+	}},
+	"DC": {name: "District of Columbia", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: append([]HolidayRule{
+		{Name: "Inauguration Day", Anchor: inaugurationDayAnchor},
+		{Name: "DC Emancipation Day", Anchor: FixedDateAnchor(time.April, 16), FirstYear: 2005, Observance: NearestWorkday},
+		juneteenth(2021),
+	}, indigenousPeoplesDay("Indigenous Peoples' Day", 2019)...)},
+	"FL": {name: "Florida", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: []HolidayRule{
+		dayAfterThanksgiving,
+	}},
+	"GA": {name: "Georgia", observance: NearestWorkday, omit: []string{"Washington's Birthday"}, rules: []HolidayRule{
+		juneteenth(2022), dayAfterThanksgiving, washingtonsBirthdayInDecember,
+	}},
+	"HI": {name: "Hawaii", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: []HolidayRule{
+		{Name: "Prince Jonah Kuhio Kalanianaole Day", Anchor: FixedDateAnchor(time.March, 26), Observance: NearestWorkday},
+		usGoodFriday,
+		{Name: "King Kamehameha I Day", Anchor: FixedDateAnchor(time.June, 11), Observance: NearestWorkday},
+		{Name: "Statehood Day", Anchor: NthWeekdayAnchor(time.August, time.Friday, 3)},
+		generalElectionDay,
+	}},
+	"ID": {name: "Idaho", observance: NearestWorkday},
+	"IL": {name: "Illinois", observance: NearestWorkday, rules: []HolidayRule{
+		lincolnsBirthday, juneteenth(2022), generalElectionDay, dayAfterThanksgiving,
+	}},
+	"IN": {name: "Indiana", observance: NearestWorkday, omit: []string{"Washington's Birthday"}, rules: []HolidayRule{
+		usGoodFriday, primaryElectionDay, generalElectionDay,
+		{Name: "Lincoln's Birthday", Anchor: thanksgivingAnchor, Offset: 1},
+		washingtonsBirthdayInDecember,
+	}},
+	"IA": {name: "Iowa", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: []HolidayRule{
+		dayAfterThanksgiving,
+	}},
+	"KS": {name: "Kansas", observance: NearestWorkday, omit: []string{"Columbus Day"}},
+	"KY": {name: "Kentucky", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: []HolidayRule{
+		{Name: "Good Friday", Anchor: EasterAnchor(), Offset: -2, Hours: &SessionHours{Closes: 12 * time.Hour}},
+		dayAfterThanksgiving, christmasEve, newYearsEve,
+	}},
+	"LA": {name: "Louisiana", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: []HolidayRule{
+		{Name: "Mardi Gras", Anchor: EasterAnchor(), Offset: -47},
+		usGoodFriday,
+	}},
+	"ME": {name: "Maine", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: append([]HolidayRule{
+		patriotsDay, juneteenth(2022), dayAfterThanksgiving,
+	}, indigenousPeoplesDay("Indigenous Peoples' Day", 2019)...)},
+	"MD": {name: "Maryland", observance: NearestWorkday, rules: []HolidayRule{
+		juneteenth(2022),
+		{Name: "American Indian Heritage Day", Anchor: thanksgivingAnchor, Offset: 1, FirstYear: 2008},
+	}},
+	"MA": {name: "Massachusetts", observance: NearestWorkday, rules: []HolidayRule{
+		patriotsDay, juneteenth(2021),
+	}},
+	"MI": {name: "Michigan", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: []HolidayRule{
+		dayAfterThanksgiving, christmasEve, newYearsEve,
+	}},
+	"MN": {name: "Minnesota", observance: NearestWorkday, rules: []HolidayRule{
+		juneteenth(2023), dayAfterThanksgiving,
+	}},
+	"MS": {name: "Mississippi", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: []HolidayRule{
+		{Name: "Confederate Memorial Day", Anchor: NthWeekdayAnchor(time.April, time.Monday, -1)},
+	}},
+	"MO": {name: "Missouri", observance: NearestWorkday, rules: []HolidayRule{
+		lincolnsBirthday,
+		{Name: "Truman Day", Anchor: FixedDateAnchor(time.May, 8), Observance: NearestWorkday},
+	}},
+	"MT": {name: "Montana", observance: NearestWorkday, rules: []HolidayRule{
+		generalElectionDay,
+	}},
+	"NE": {name: "Nebraska", observance: NearestWorkday, rules: []HolidayRule{
+		{Name: "Arbor Day", Anchor: NthWeekdayAnchor(time.April, time.Friday, -1)},
+		dayAfterThanksgiving,
+	}},
+	"NV": {name: "Nevada", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: []HolidayRule{
+		juneteenth(2022),
+		{Name: "Nevada Day", Anchor: NthWeekdayAnchor(time.October, time.Friday, -1)},
+		{Name: "Family Day", Anchor: thanksgivingAnchor, Offset: 1},
+	}},
+	"NH": {name: "New Hampshire", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: []HolidayRule{
+		dayAfterThanksgiving,
+	}},
+	"NJ": {name: "New Jersey", observance: NearestWorkday, rules: []HolidayRule{
+		lincolnsBirthday, usGoodFriday, juneteenth(2021), electionDay,
+	}},
+	"NM": {name: "New Mexico", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: append([]HolidayRule{
+		dayAfterThanksgiving,
+	}, indigenousPeoplesDay("Indigenous Peoples' Day", 2019)...)},
+	"NY": {name: "New York", observance: NearestWorkday, rules: []HolidayRule{
+		lincolnsBirthday, juneteenth(2020), electionDay,
+	}},
+	"NC": {name: "North Carolina", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: []HolidayRule{
+		usGoodFriday, dayAfterThanksgiving, christmasEve, dayAfterChristmas,
+	}},
+	"ND": {name: "North Dakota", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: []HolidayRule{
+		usGoodFriday,
+	}},
+	"OH": {name: "Ohio", observance: NearestWorkday},
+	"OK": {name: "Oklahoma", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: []HolidayRule{
+		dayAfterThanksgiving, christmasEve,
+	}},
+	"OR": {name: "Oregon", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: []HolidayRule{
+		juneteenth(2022),
+	}},
+	"PA": {name: "Pennsylvania", observance: NearestWorkday, rules: []HolidayRule{
+		dayAfterThanksgiving,
+	}},
+	"RI": {name: "Rhode Island", observance: NearestWorkday, rules: []HolidayRule{
+		{Name: "Victory Day", Anchor: NthWeekdayAnchor(time.August, time.Monday, 2)},
+	}},
+	"SC": {name: "South Carolina", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: []HolidayRule{
+		{Name: "Confederate Memorial Day", Anchor: FixedDateAnchor(time.May, 10), Observance: NearestWorkday},
+		dayAfterThanksgiving, christmasEve, dayAfterChristmas,
+	}},
+	"SD": {name: "South Dakota", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: indigenousPeoplesDay("Native Americans' Day", 1990)},
+	"TN": {name: "Tennessee", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: []HolidayRule{
+		usGoodFriday, dayAfterThanksgiving, christmasEve,
+	}},
+	// Texas does not move a holiday that falls on a weekend
+	"TX": {name: "Texas", observance: nil, omit: []string{"Columbus Day"}, rules: []HolidayRule{
+		{Name: "Confederate Heroes Day", Anchor: FixedDateAnchor(time.January, 19)},
+		{Name: "Texas Independence Day", Anchor: FixedDateAnchor(time.March, 2)},
+		{Name: "San Jacinto Day", Anchor: FixedDateAnchor(time.April, 21)},
+		{Name: "Emancipation Day in Texas", Anchor: FixedDateAnchor(time.June, 19)},
+		{Name: "Lyndon Baines Johnson Day", Anchor: FixedDateAnchor(time.August, 27)},
+		dayAfterThanksgiving, christmasEve, dayAfterChristmas,
+	}},
+	"UT": {name: "Utah", observance: NearestWorkday, rules: []HolidayRule{
+		{Name: "Pioneer Day", Anchor: FixedDateAnchor(time.July, 24), Observance: NearestWorkday},
+	}},
+	"VT": {name: "Vermont", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: append([]HolidayRule{
+		{Name: "Town Meeting Day", Anchor: NthWeekdayAnchor(time.March, time.Tuesday, 1)},
+		{Name: "Bennington Battle Day", Anchor: FixedDateAnchor(time.August, 16), Observance: NearestWorkday},
+	}, indigenousPeoplesDay("Indigenous Peoples' Day", 2019)...)},
+	"VA": {name: "Virginia", observance: NearestWorkday, rules: []HolidayRule{
+		// the Friday before Martin Luther King Day
+		{Name: "Lee-Jackson Day", Anchor: NthWeekdayAnchor(time.January, time.Monday, 3), Offset: -3, LastYear: 2020},
+		juneteenth(2020),
+		{Name: "Election Day", Anchor: usElectionDayAnchor, Offset: 1, FirstYear: 2020},
+		dayAfterThanksgiving, christmasEve,
+	}},
+	"WA": {name: "Washington", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: []HolidayRule{
+		juneteenth(2022),
+		{Name: "Native American Heritage Day", Anchor: thanksgivingAnchor, Offset: 1},
+	}},
+	"WV": {name: "West Virginia", observance: NearestWorkday, rules: []HolidayRule{
+		{Name: "West Virginia Day", Anchor: FixedDateAnchor(time.June, 20), Observance: NearestWorkday},
+		generalElectionDay, dayAfterThanksgiving,
+		{Name: "Christmas Eve", Anchor: FixedDateAnchor(time.December, 24), Hours: &SessionHours{Closes: 12 * time.Hour}},
+		{Name: "New Year's Eve", Anchor: FixedDateAnchor(time.December, 31), Hours: &SessionHours{Closes: 12 * time.Hour}},
+	}},
+	"WI": {name: "Wisconsin", observance: NearestWorkday, omit: []string{"Columbus Day"}, rules: []HolidayRule{
+		christmasEve, newYearsEve,
+	}},
+	"WY": {name: "Wyoming", observance: NearestWorkday, omit: []string{"Columbus Day"}},
+}
+
+// USStates returns the postal codes of the states, and DC, with a calendar
+func USStates() []string {
+	states := make([]string, 0, len(usStates))
+	for state := range usStates {
+		states = append(states, state)
+	}
+	sort.Strings(states)
+	return states
+}
+
+// NewUSStateCalendar returns the state government holiday calendar of a US
+// state, by its postal code
+func NewUSStateCalendar(state string) (*RuleCalendar, error) {
+	st, found := usStates[state]
+	if !found {
+		return nil, fmt.Errorf("no holiday calendar for state %q", state)
+	}
+	omit := map[string]bool{"Juneteenth National Independence Day": true}
+	for _, name := range st.omit {
+		omit[name] = true
+	}
+	var rules []HolidayRule
+	for _, rule := range usFederalRules(st.observance) {
+		if !omit[rule.Name] {
+			rules = append(rules, rule)
+		}
+	}
+	return NewRuleCalendar(st.name, saturdaySundayWeekend, append(rules, st.rules...)), nil
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUSStateCalendar(t *testing.T) {
+	dates := func(yyyy int, mmdd ...int) []time.Time {
+		var dates []time.Time
+		for _, date := range mmdd {
+			dates = append(dates, time.Date(yyyy, time.Month(date/100), date%100, 0, 0, 0, 0, time.UTC))
+		}
+		return dates
+	}
+	// the holiday schedules published by the state governments
+	tests := []struct {
+		state string
+		want  []time.Time
+	}{
+		{"CA", dates(2024, 101, 115, 219, 401, 527, 704, 902, 1111, 1128, 1129, 1225)},
+		{"CA", dates(2025, 101, 120, 217, 331, 526, 704, 901, 1111, 1127, 1128, 1225)},
+		{"TX", dates(2024, 101, 115, 119, 219, 302, 421, 527, 619, 704, 827, 902, 1111, 1128, 1129, 1224, 1225, 1226)},
+		{"TX", dates(2025, 101, 119, 120, 217, 302, 421, 526, 619, 704, 827, 901, 1111, 1127, 1128, 1224, 1225, 1226)},
+		{"GA", dates(2024, 101, 115, 527, 619, 704, 902, 1014, 1111, 1128, 1129, 1224, 1225)},
+		{"GA", dates(2025, 101, 120, 526, 619, 704, 901, 1013, 1111, 1127, 1128, 1224, 1225)},
+		{"IN", dates(2024, 101, 115, 329, 507, 527, 704, 902, 1014, 1105, 1111, 1128, 1129, 1224, 1225)},
+		{"IN", dates(2025, 101, 120, 418, 526, 704, 901, 1013, 1111, 1127, 1128, 1224, 1225)},
+		{"MA", dates(2024, 101, 115, 219, 415, 527, 619, 704, 902, 1014, 1111, 1128, 1225)},
+		{"MA", dates(2025, 101, 120, 217, 421, 526, 619, 704, 901, 1013, 1111, 1127, 1225)},
+		{"DC", dates(2024, 101, 115, 219, 416, 527, 619, 704, 902, 1014, 1111, 1128, 1225)},
+		// Inauguration Day on Martin Luther King Day
+		{"DC", dates(2025, 101, 120, 120, 217, 416, 526, 619, 704, 901, 1013, 1111, 1127, 1225)},
+	}
+	for _, test := range tests {
+		cal, err := NewUSStateCalendar(test.state)
+		if err != nil {
+			t.Fatal(err)
+		}
+		yyyy := test.want[0].Year()
+		holidays := cal.Holidays(yyyy)
+		if len(holidays) != len(test.want) {
+			t.Errorf("%s %d: got %d holidays, want %d: %v", test.state, yyyy, len(holidays), len(test.want), holidays)
+			continue
+		}
+		for i, holiday := range holidays {
+			if !holiday.Date.Equal(test.want[i]) {
+				t.Errorf("%s %d: %s on %s, want %s", test.state, yyyy, holiday.Name, holiday.Date.Format("2006-01-02"), test.want[i].Format("2006-01-02"))
+			}
+		}
+	}
+}
+
+func TestUSStateCalendarUnknownState(t *testing.T) {
+	if _, err := NewUSStateCalendar("PR"); err == nil {
+		t.Error("NewUSStateCalendar(PR) returned no error")
+	}
+}